@@ -0,0 +1,119 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+)
+
+// ACLProvider answers whether a tenant may invoke a recipe. Consulted
+// for every request before execution; requests for which Allowed
+// returns false fail with a 403 ErrCodeForbidden response without
+// touching the handler, cache, or concurrency limits.
+//
+// Implementations must be safe for concurrent use.
+type ACLProvider interface {
+	Allowed(tenantID, recipe string) bool
+}
+
+// ACLProviderFunc adapts a plain function to the ACLProvider interface.
+type ACLProviderFunc func(tenantID, recipe string) bool
+
+// Allowed implements ACLProvider.
+func (f ACLProviderFunc) Allowed(tenantID, recipe string) bool {
+	return f(tenantID, recipe)
+}
+
+// staticACL is the ACLProvider behind WithRecipeACL: a fixed
+// tenant → recipe-set entitlement table.
+type staticACL struct {
+	allowed map[string]map[string]bool
+}
+
+func (a *staticACL) Allowed(tenantID, recipe string) bool {
+	return a.allowed[tenantID][recipe]
+}
+
+// WithRecipeACL restricts each tenant to an explicit list of recipes.
+// Tenants absent from the map are denied everything (default-deny), so
+// every tenant you serve must be listed. Denied requests return 403
+// with ErrCodeForbidden and are reported to the audit hook, if one is
+// set. For dynamic entitlements backed by a database or config service,
+// use WithACLProvider instead.
+//
+// Panics if acl is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithRecipeACL(map[string][]string{
+//		"tenant-free": {"get-user"},
+//		"tenant-pro":  {"get-user", "bulk-export"},
+//	}))
+func WithRecipeACL(acl map[string][]string) Option {
+	if acl == nil {
+		panic("relayer: WithRecipeACL requires a non-nil map")
+	}
+	allowed := make(map[string]map[string]bool, len(acl))
+	for tenantID, recipes := range acl {
+		set := make(map[string]bool, len(recipes))
+		for _, recipe := range recipes {
+			set[recipe] = true
+		}
+		allowed[tenantID] = set
+	}
+	return WithACLProvider(&staticACL{allowed: allowed})
+}
+
+// WithACLProvider sets a pluggable entitlement check consulted for
+// every request. Pass nil to disable (the default), allowing every
+// tenant to invoke every recipe.
+func WithACLProvider(provider ACLProvider) Option {
+	return func(o *Orchestrator) {
+		o.acl = provider
+	}
+}
+
+// AuditHook observes authorization denials — requests rejected by the
+// AuthHook or by the recipe ACL — so they can be logged or alerted on.
+// Called synchronously with the denial response before it is returned.
+//
+// Implementations must be safe for concurrent use.
+type AuditHook interface {
+	OnDenied(ctx context.Context, req SubRequest, resp Response)
+}
+
+// AuditHookFunc adapts a plain function to the AuditHook interface.
+type AuditHookFunc func(ctx context.Context, req SubRequest, resp Response)
+
+// OnDenied implements AuditHook.
+func (f AuditHookFunc) OnDenied(ctx context.Context, req SubRequest, resp Response) {
+	f(ctx, req, resp)
+}
+
+// WithAuditHook sets the hook receiving authorization denials. Pass
+// nil to disable (the default).
+func WithAuditHook(hook AuditHook) Option {
+	return func(o *Orchestrator) {
+		o.auditHook = hook
+	}
+}
+
+// auditDenied reports an authorization denial to the audit hook.
+func (o *Orchestrator) auditDenied(ctx context.Context, req SubRequest, resp Response) {
+	if o.auditHook != nil {
+		o.auditHook.OnDenied(ctx, o.redactRequest(req), resp)
+	}
+}
+
+// aclDeniedResponse builds the 403 returned when the recipe ACL denies
+// a request.
+func aclDeniedResponse(req SubRequest) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   403,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeForbidden,
+			Message: fmt.Sprintf("tenant %q is not entitled to recipe %q", req.TenantID, req.Recipe),
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func newACLOrchestrator(opts ...Option) *Orchestrator {
+	orch := New(opts...)
+	orch.RegisterRecipe("get-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "user", nil
+	})
+	orch.RegisterRecipe("bulk-export", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "export", nil
+	})
+	return orch
+}
+
+func TestWithRecipeACL_EnforcesEntitlements(t *testing.T) {
+	orch := newACLOrchestrator(WithRecipeACL(map[string][]string{
+		"tenant-free": {"get-user"},
+		"tenant-pro":  {"get-user", "bulk-export"},
+	}))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-free", Recipe: "get-user"},
+		{ID: "2", TenantID: "tenant-free", Recipe: "bulk-export"},
+		{ID: "3", TenantID: "tenant-pro", Recipe: "bulk-export"},
+	})
+
+	byID := make(map[string]Response, len(results))
+	for _, r := range results {
+		byID[r.ID] = r
+	}
+
+	if byID["1"].Status != 200 {
+		t.Errorf("entitled recipe: Status = %d, want 200", byID["1"].Status)
+	}
+	if byID["2"].Status != 403 {
+		t.Errorf("unentitled recipe: Status = %d, want 403", byID["2"].Status)
+	}
+	if byID["2"].Error == nil || byID["2"].Error.Code != ErrCodeForbidden {
+		t.Errorf("Error = %v, want code %s", byID["2"].Error, ErrCodeForbidden)
+	}
+	if byID["3"].Status != 200 {
+		t.Errorf("pro tenant: Status = %d, want 200", byID["3"].Status)
+	}
+}
+
+func TestWithRecipeACL_UnlistedTenantDenied(t *testing.T) {
+	orch := newACLOrchestrator(WithRecipeACL(map[string][]string{
+		"tenant-pro": {"get-user"},
+	}))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-unknown", Recipe: "get-user"},
+	})
+
+	if results[0].Status != 403 {
+		t.Errorf("Status = %d, want 403 for unlisted tenant", results[0].Status)
+	}
+}
+
+func TestWithRecipeACL_NilMapPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithRecipeACL(nil) did not panic")
+		}
+	}()
+	WithRecipeACL(nil)
+}
+
+func TestWithACLProvider_Pluggable(t *testing.T) {
+	orch := newACLOrchestrator(WithACLProvider(ACLProviderFunc(func(tenantID, recipe string) bool {
+		return recipe != "bulk-export"
+	})))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "get-user"},
+		{ID: "2", TenantID: "t", Recipe: "bulk-export"},
+	})
+
+	byID := map[string]Response{results[0].ID: results[0], results[1].ID: results[1]}
+	if byID["1"].Status != 200 {
+		t.Errorf("allowed recipe: Status = %d, want 200", byID["1"].Status)
+	}
+	if byID["2"].Status != 403 {
+		t.Errorf("denied recipe: Status = %d, want 403", byID["2"].Status)
+	}
+}
+
+func TestWithAuditHook_ReceivesDenials(t *testing.T) {
+	var mu sync.Mutex
+	var denied []SubRequest
+	orch := newACLOrchestrator(
+		WithRecipeACL(map[string][]string{"tenant-pro": {"get-user"}}),
+		WithAuditHook(AuditHookFunc(func(ctx context.Context, req SubRequest, resp Response) {
+			mu.Lock()
+			denied = append(denied, req)
+			mu.Unlock()
+			if resp.Status != 403 {
+				t.Errorf("audit resp.Status = %d, want 403", resp.Status)
+			}
+		})),
+	)
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "ok", TenantID: "tenant-pro", Recipe: "get-user"},
+		{ID: "denied", TenantID: "tenant-pro", Recipe: "bulk-export"},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(denied) != 1 || denied[0].ID != "denied" {
+		t.Errorf("audited denials = %v, want exactly the denied request", denied)
+	}
+}
+
+func TestWithAuditHook_ReceivesAuthHookDenials(t *testing.T) {
+	var mu sync.Mutex
+	audited := 0
+	orch := newACLOrchestrator(
+		WithAuthHook(AuthHookFunc(func(ctx context.Context, req SubRequest) error {
+			return ErrUnauthorized
+		})),
+		WithAuditHook(AuditHookFunc(func(ctx context.Context, req SubRequest, resp Response) {
+			mu.Lock()
+			audited++
+			mu.Unlock()
+		})),
+	)
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "get-user"},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if audited != 1 {
+		t.Errorf("audited = %d, want 1", audited)
+	}
+}
@@ -0,0 +1,105 @@
+package relayer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// ErrNoJobStore is returned by the async API when no JobStore is configured.
+var ErrNoJobStore = errors.New("no job store configured; use WithJobStore")
+
+// WithJobStore enables the asynchronous batch API (SubmitBatch / GetJob /
+// ListJobs), persisting submitted batches and their results to the store.
+//
+// Example:
+//
+//	store, _ := relayer.NewFileJobStore("/var/lib/relayer/jobs")
+//	orch := relayer.New(relayer.WithJobStore(store))
+func WithJobStore(store JobStore) Option {
+	return func(o *Orchestrator) {
+		o.jobStore = store
+	}
+}
+
+// SubmitBatch persists the batch and executes it in the background,
+// returning a batch ID immediately. Progress and results are available
+// via GetJob. The batch executes detached from the submission context:
+// cancelling ctx after SubmitBatch returns does not cancel execution.
+//
+// Example:
+//
+//	batchID, err := orch.SubmitBatch(ctx, batch)
+//	...
+//	job, err := orch.GetJob(batchID)
+//	if job.Status == relayer.JobCompleted {
+//		process(job.Results)
+//	}
+func (o *Orchestrator) SubmitBatch(ctx context.Context, batch []SubRequest) (string, error) {
+	if o.jobStore == nil {
+		return "", ErrNoJobStore
+	}
+	if len(batch) == 0 {
+		return "", errors.New("cannot submit an empty batch")
+	}
+
+	batchID := newBatchID()
+	job := &Job{
+		BatchID:     batchID,
+		Status:      JobPending,
+		Requests:    append([]SubRequest(nil), batch...),
+		SubmittedAt: time.Now(),
+	}
+
+	if err := o.jobStore.SaveJob(job); err != nil {
+		return "", err
+	}
+
+	go func() {
+		job.Status = JobRunning
+		o.jobStore.UpdateJob(job)
+
+		// Detach from the submission context; per-request timeouts still apply.
+		job.Results = o.executeJobTracked(context.WithoutCancel(ctx), job)
+
+		job.Status = JobCompleted
+		job.CompletedAt = time.Now()
+		o.jobStore.UpdateJob(job)
+	}()
+
+	return batchID, nil
+}
+
+// GetJob returns the persisted state of an asynchronously submitted batch.
+func (o *Orchestrator) GetJob(batchID string) (*Job, error) {
+	if o.jobStore == nil {
+		return nil, ErrNoJobStore
+	}
+	return o.jobStore.GetJob(batchID)
+}
+
+// ListJobs returns persisted jobs containing requests for the tenant;
+// an empty tenant ID returns all jobs.
+func (o *Orchestrator) ListJobs(tenantID string) ([]*Job, error) {
+	if o.jobStore == nil {
+		return nil, ErrNoJobStore
+	}
+	return o.jobStore.ListJobs(tenantID)
+}
+
+// newBatchID returns a 16-byte random hex identifier.
+func newBatchID() string {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// newTraceID returns an 8-byte random hex identifier for request
+// correlation.
+func newTraceID() string {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,70 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+)
+
+// AuthHook authorizes requests before execution, giving transports a
+// standard place to enforce per-tenant API keys or JWT claims against
+// the requested recipe. Called before any execution resources (cache
+// lookups, rate limit tokens, concurrency slots) are consumed.
+//
+// Return nil to admit the request. Return an error wrapping
+// ErrUnauthorized for a 401 (caller not authenticated); any other error
+// — including ErrForbidden — produces a 403 (authenticated but not
+// entitled). The error message is surfaced in the structured response
+// error.
+type AuthHook interface {
+	Authorize(ctx context.Context, req SubRequest) error
+}
+
+// AuthHookFunc adapts a plain function to the AuthHook interface.
+type AuthHookFunc func(ctx context.Context, req SubRequest) error
+
+// Authorize implements AuthHook.
+func (f AuthHookFunc) Authorize(ctx context.Context, req SubRequest) error {
+	return f(ctx, req)
+}
+
+// WithAuthHook sets the hook authorizing every request before
+// execution. Pass nil to disable (the default).
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithAuthHook(relayer.AuthHookFunc(
+//		func(ctx context.Context, req relayer.SubRequest) error {
+//			if !keys.Valid(ctx) {
+//				return relayer.ErrUnauthorized
+//			}
+//			if !keys.Entitled(req.TenantID, req.Recipe) {
+//				return fmt.Errorf("%w: recipe %s", relayer.ErrForbidden, req.Recipe)
+//			}
+//			return nil
+//		})))
+func WithAuthHook(hook AuthHook) Option {
+	return func(o *Orchestrator) {
+		o.authHook = hook
+	}
+}
+
+// authFailureResponse converts an authorization error into the 401/403
+// response: 401 for unauthenticated callers (errors wrapping
+// ErrUnauthorized), 403 otherwise.
+func authFailureResponse(req SubRequest, err error) Response {
+	status := 403
+	code := ErrCodeForbidden
+	if errors.Is(err, ErrUnauthorized) {
+		status = 401
+		code = ErrCodeUnauthorized
+	}
+	return Response{
+		ID:       req.ID,
+		Status:   status,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    code,
+			Message: err.Error(),
+		},
+	}
+}
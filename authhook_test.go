@@ -0,0 +1,107 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAuthHook_UnauthorizedReturns401(t *testing.T) {
+	orch := New(WithAuthHook(AuthHookFunc(func(ctx context.Context, req SubRequest) error {
+		return ErrUnauthorized
+	})))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-1", Recipe: "echo"},
+	})
+
+	if results[0].Status != 401 {
+		t.Errorf("Status = %d, want 401", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeUnauthorized {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodeUnauthorized)
+	}
+}
+
+func TestAuthHook_ForbiddenReturns403(t *testing.T) {
+	orch := New(WithAuthHook(AuthHookFunc(func(ctx context.Context, req SubRequest) error {
+		return fmt.Errorf("%w: recipe %s", ErrForbidden, req.Recipe)
+	})))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-1", Recipe: "echo"},
+	})
+
+	if results[0].Status != 403 {
+		t.Errorf("Status = %d, want 403", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeForbidden {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodeForbidden)
+	}
+}
+
+func TestAuthHook_DeniedRequestNeverExecutes(t *testing.T) {
+	var executed atomic.Bool
+	orch := New(WithAuthHook(AuthHookFunc(func(ctx context.Context, req SubRequest) error {
+		return ErrForbidden
+	})))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed.Store(true)
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-1", Recipe: "echo"},
+	})
+
+	if executed.Load() {
+		t.Error("handler executed despite authorization failure")
+	}
+}
+
+func TestAuthHook_AdmittedRequestExecutesNormally(t *testing.T) {
+	orch := New(WithAuthHook(AuthHookFunc(func(ctx context.Context, req SubRequest) error {
+		if req.TenantID == "banned" {
+			return ErrForbidden
+		}
+		return nil
+	})))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-1", Recipe: "echo", Payload: "hi"},
+		{ID: "2", TenantID: "banned", Recipe: "echo", Payload: "hi"},
+	})
+
+	byID := map[string]Response{results[0].ID: results[0], results[1].ID: results[1]}
+	if byID["1"].Status != 200 {
+		t.Errorf("admitted request: Status = %d, want 200", byID["1"].Status)
+	}
+	if byID["2"].Status != 403 {
+		t.Errorf("denied request: Status = %d, want 403", byID["2"].Status)
+	}
+}
+
+func TestAuthHook_NilHookAdmitsEverything(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-1", Recipe: "echo", Payload: "hi"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
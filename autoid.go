@@ -0,0 +1,43 @@
+package relayer
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// IDGenerator produces a request ID. Generators run concurrently and
+// must return unique values.
+type IDGenerator func() string
+
+// WithAutoRequestID fills empty SubRequest.IDs with generated ones
+// instead of rejecting the request with 400, so fire-and-forget clients
+// don't have to track IDs. The generated ID is returned in the
+// response, letting interested callers correlate after the fact. Pass
+// nil to use the default generator, which produces UUIDv7 values
+// (time-ordered, so generated IDs sort by submission time).
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithAutoRequestID(nil))
+func WithAutoRequestID(generator IDGenerator) Option {
+	return func(o *Orchestrator) {
+		if generator == nil {
+			generator = UUIDv7
+		}
+		o.autoID = generator
+	}
+}
+
+// UUIDv7 returns a random UUID version 7: a 48-bit millisecond
+// timestamp followed by random bits, formatted in the canonical
+// 8-4-4-4-12 form. The default generator for WithAutoRequestID.
+func UUIDv7() string {
+	var uuid [16]byte
+	binary.BigEndian.PutUint64(uuid[:8], uint64(time.Now().UnixMilli())<<16)
+	rand.Read(uuid[6:])
+	uuid[6] = (uuid[6] & 0x0f) | 0x70 // Version 7
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
@@ -0,0 +1,80 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"testing"
+)
+
+var uuidV7Pattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestWithAutoRequestID_FillsEmptyIDs(t *testing.T) {
+	orch := New(WithAutoRequestID(nil))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{TenantID: "t", Recipe: "echo", Payload: "hi"},
+		{ID: "explicit", TenantID: "t", Recipe: "echo", Payload: "hi"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 for auto-ID request", results[0].Status)
+	}
+	if !uuidV7Pattern.MatchString(results[0].ID) {
+		t.Errorf("ID = %q, want a UUIDv7", results[0].ID)
+	}
+	if results[1].ID != "explicit" {
+		t.Errorf("ID = %q, want the caller-provided ID kept", results[1].ID)
+	}
+}
+
+func TestWithAutoRequestID_CustomGenerator(t *testing.T) {
+	var n atomic.Int64
+	orch := New(WithAutoRequestID(func() string {
+		return fmt.Sprintf("gen-%d", n.Add(1))
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].ID != "gen-1" {
+		t.Errorf("ID = %q, want gen-1", results[0].ID)
+	}
+}
+
+func TestWithAutoRequestID_DefaultStillRejectsEmptyIDs(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 400 {
+		t.Errorf("Status = %d, want 400 without the option", results[0].Status)
+	}
+}
+
+func TestUUIDv7_UniqueAndOrdered(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		id := UUIDv7()
+		if seen[id] {
+			t.Fatalf("duplicate UUID %s", id)
+		}
+		seen[id] = true
+		if !uuidV7Pattern.MatchString(id) {
+			t.Fatalf("UUID %q does not match the v7 pattern", id)
+		}
+	}
+}
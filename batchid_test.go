@@ -0,0 +1,81 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBatchID_SharedAcrossBatch(t *testing.T) {
+	orch := New()
+
+	seen := make(chan string, 2)
+	orch.RegisterRecipe("observe", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		id, _ := BatchID(ctx)
+		seen <- id
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "observe"},
+		{ID: "2", TenantID: "t", Recipe: "observe"},
+	})
+
+	first, second := <-seen, <-seen
+	if first == "" || first != second {
+		t.Errorf("handlers saw batch IDs (%q, %q), want one shared non-empty ID", first, second)
+	}
+	if results[0].BatchID != first || results[1].BatchID != first {
+		t.Errorf("Response.BatchID = (%q, %q), want %q on both", results[0].BatchID, results[1].BatchID, first)
+	}
+}
+
+func TestBatchID_CallerSupplied(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	ctx := WithBatchID(context.Background(), "import-2024-03")
+	results := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].BatchID != "import-2024-03" {
+		t.Errorf("BatchID = %q, want import-2024-03", results[0].BatchID)
+	}
+}
+
+func TestBatchID_DiffersBetweenBatches(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	a := orch.ExecuteBatch(context.Background(), []SubRequest{{ID: "1", TenantID: "t", Recipe: "ok"}})
+	b := orch.ExecuteBatch(context.Background(), []SubRequest{{ID: "1", TenantID: "t", Recipe: "ok"}})
+
+	if a[0].BatchID == b[0].BatchID {
+		t.Errorf("separate batches share batch ID %q", a[0].BatchID)
+	}
+}
+
+func TestBatchID_AsyncJobsUseJobID(t *testing.T) {
+	orch := New(WithJobStore(NewMemoryJobStore()))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	batchID, err := orch.SubmitBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitBatch() error = %v", err)
+	}
+
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+
+	job, _ := orch.GetJob(batchID)
+	if job.Results[0].BatchID != batchID {
+		t.Errorf("Results[0].BatchID = %q, want the job's batch ID %q", job.Results[0].BatchID, batchID)
+	}
+}
@@ -0,0 +1,74 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// BatchSummary aggregates outcome counts for a batch execution.
+// Successes counts 2xx responses; everything else is a failure.
+type BatchSummary struct {
+	Total     int           `json:"total"`
+	Successes int           `json:"successes"`
+	Failures  int           `json:"failures"`
+	Duration  time.Duration `json:"duration_ms"` // Wall-clock time for the whole batch
+}
+
+// BatchResult bundles batch responses with a precomputed summary, so
+// transports do not have to recount successes and failures by hand.
+type BatchResult struct {
+	Responses []Response   `json:"responses"`
+	Summary   BatchSummary `json:"summary"`
+}
+
+// Succeeded returns the responses with 2xx status codes.
+func (r BatchResult) Succeeded() []Response {
+	return FilterSuccess(r.Responses)
+}
+
+// Failed returns the responses with non-2xx status codes.
+func (r BatchResult) Failed() []Response {
+	failed := make([]Response, 0, r.Summary.Failures)
+	for _, resp := range r.Responses {
+		if resp.Status < 200 || resp.Status >= 300 {
+			failed = append(failed, resp)
+		}
+	}
+	return failed
+}
+
+// ByTenant groups the responses by tenant ID.
+func (r BatchResult) ByTenant() map[string][]Response {
+	grouped := make(map[string][]Response)
+	for _, resp := range r.Responses {
+		grouped[resp.TenantID] = append(grouped[resp.TenantID], resp)
+	}
+	return grouped
+}
+
+// ExecuteBatchResult executes a batch like ExecuteBatch and returns the
+// responses together with a summary of counts and total duration.
+//
+// Example:
+//
+//	result := orch.ExecuteBatchResult(ctx, batch)
+//	log.Printf("%d/%d succeeded in %v",
+//		result.Summary.Successes, result.Summary.Total, result.Summary.Duration)
+func (o *Orchestrator) ExecuteBatchResult(ctx context.Context, batch []SubRequest) BatchResult {
+	start := time.Now()
+	responses := o.ExecuteBatch(ctx, batch)
+
+	summary := BatchSummary{
+		Total:    len(responses),
+		Duration: time.Since(start),
+	}
+	for _, resp := range responses {
+		if resp.Status >= 200 && resp.Status < 300 {
+			summary.Successes++
+		} else {
+			summary.Failures++
+		}
+	}
+
+	return BatchResult{Responses: responses, Summary: summary}
+}
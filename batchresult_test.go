@@ -0,0 +1,63 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestExecuteBatchResult_Summary(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "fine", nil
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	result := orch.ExecuteBatchResult(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "a", Recipe: "ok"},
+		{ID: "2", TenantID: "a", Recipe: "fail"},
+		{ID: "3", TenantID: "b", Recipe: "ok"},
+	})
+
+	if result.Summary.Total != 3 {
+		t.Errorf("Total = %d, want 3", result.Summary.Total)
+	}
+	if result.Summary.Successes != 2 {
+		t.Errorf("Successes = %d, want 2", result.Summary.Successes)
+	}
+	if result.Summary.Failures != 1 {
+		t.Errorf("Failures = %d, want 1", result.Summary.Failures)
+	}
+	if result.Summary.Duration <= 0 {
+		t.Error("Duration should be positive")
+	}
+
+	if got := result.Succeeded(); len(got) != 2 {
+		t.Errorf("Succeeded() returned %d responses, want 2", len(got))
+	}
+
+	failed := result.Failed()
+	if len(failed) != 1 || failed[0].ID != "2" {
+		t.Errorf("Failed() = %v, want single response with ID 2", failed)
+	}
+
+	byTenant := result.ByTenant()
+	if len(byTenant["a"]) != 2 || len(byTenant["b"]) != 1 {
+		t.Errorf("ByTenant() grouping = a:%d b:%d, want a:2 b:1", len(byTenant["a"]), len(byTenant["b"]))
+	}
+}
+
+func TestExecuteBatchResult_EmptyBatch(t *testing.T) {
+	orch := New()
+
+	result := orch.ExecuteBatchResult(context.Background(), nil)
+	if result.Summary.Total != 0 || result.Summary.Successes != 0 || result.Summary.Failures != 0 {
+		t.Errorf("Summary = %+v, want all zero", result.Summary)
+	}
+	if len(result.ByTenant()) != 0 {
+		t.Error("ByTenant() should be empty for empty batch")
+	}
+}
@@ -0,0 +1,153 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// BenchmarkOptions configures a BenchmarkRecipe run.
+// Zero values fall back to sensible defaults.
+type BenchmarkOptions struct {
+	Iterations int           // Number of measured runs (default 100)
+	Warmup     int           // Number of unmeasured warmup runs (default 10)
+	TenantID   string        // Tenant ID used for the synthetic requests (default "benchmark")
+	Timeout    time.Duration // Per-run timeout (default: orchestrator/recipe timeout)
+}
+
+// BenchmarkResult reports the latency distribution and allocation
+// behavior of a benchmarked recipe.
+type BenchmarkResult struct {
+	Recipe      string        `json:"recipe"`
+	Iterations  int           `json:"iterations"`
+	Errors      int           `json:"errors"` // Runs that returned a non-2xx response
+	Min         time.Duration `json:"min"`
+	Max         time.Duration `json:"max"`
+	Mean        time.Duration `json:"mean"`
+	P50         time.Duration `json:"p50"`
+	P95         time.Duration `json:"p95"`
+	P99         time.Duration `json:"p99"`
+	AllocsPerOp uint64        `json:"allocs_per_op"` // Heap allocations per run
+	BytesPerOp  uint64        `json:"bytes_per_op"`  // Heap bytes allocated per run
+}
+
+// BenchmarkRecipe runs a registered recipe repeatedly with the given payload
+// and reports its latency distribution and allocation cost. It is intended
+// for in-place profiling from admin endpoints or CLIs, not as a replacement
+// for `go test -bench`.
+//
+// The recipe executes with the full production path (context enrichment,
+// timeout, panic recovery) but hooks are not invoked, so benchmarking does
+// not pollute metrics or logs.
+//
+// Example:
+//
+//	result, err := orch.BenchmarkRecipe("get-user", "user-123", nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("p99: %v, allocs/op: %d\n", result.P99, result.AllocsPerOp)
+func (o *Orchestrator) BenchmarkRecipe(name string, payload interface{}, opts *BenchmarkOptions) (*BenchmarkResult, error) {
+	o.mu.RLock()
+	_, exists := o.registry[name]
+	o.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("recipe '%s' not found", name)
+	}
+
+	options := BenchmarkOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Iterations <= 0 {
+		options.Iterations = 100
+	}
+	if options.Warmup < 0 {
+		options.Warmup = 0
+	} else if options.Warmup == 0 {
+		options.Warmup = 10
+	}
+	if options.TenantID == "" {
+		options.TenantID = "benchmark"
+	}
+
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = o.timeout
+		o.mu.RLock()
+		if recipeOpt, ok := o.recipeOptions[name]; ok && recipeOpt.Timeout > 0 {
+			timeout = recipeOpt.Timeout
+		}
+		o.mu.RUnlock()
+	}
+
+	run := func(i int) Response {
+		req := SubRequest{
+			ID:       fmt.Sprintf("bench-%d", i),
+			TenantID: options.TenantID,
+			Recipe:   name,
+			Payload:  payload,
+		}
+		ctx := WithTenantID(context.Background(), req.TenantID)
+		ctx = WithRequestID(ctx, req.ID)
+		ctx = WithRecipeName(ctx, name)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp, _ := o.safeExecute(ctx, req)
+		return resp
+	}
+
+	// Warmup runs are not measured; they prime caches, connection pools, etc.
+	for i := 0; i < options.Warmup; i++ {
+		run(i)
+	}
+
+	durations := make([]time.Duration, 0, options.Iterations)
+	errors := 0
+	var total time.Duration
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	for i := 0; i < options.Iterations; i++ {
+		start := time.Now()
+		resp := run(i)
+		elapsed := time.Since(start)
+
+		durations = append(durations, elapsed)
+		total += elapsed
+		if resp.Status < 200 || resp.Status >= 300 {
+			errors++
+		}
+	}
+
+	runtime.ReadMemStats(&after)
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	n := uint64(options.Iterations)
+	return &BenchmarkResult{
+		Recipe:      name,
+		Iterations:  options.Iterations,
+		Errors:      errors,
+		Min:         durations[0],
+		Max:         durations[len(durations)-1],
+		Mean:        total / time.Duration(options.Iterations),
+		P50:         percentile(durations, 0.50),
+		P95:         percentile(durations, 0.95),
+		P99:         percentile(durations, 0.99),
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / n,
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / n,
+	}, nil
+}
+
+// percentile returns the value at quantile q from a sorted duration slice.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted)-1) * q)
+	return sorted[idx]
+}
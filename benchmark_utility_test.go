@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBenchmarkRecipe_Basic(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	result, err := orch.BenchmarkRecipe("echo", "payload", &BenchmarkOptions{
+		Iterations: 50,
+		Warmup:     5,
+	})
+
+	if err != nil {
+		t.Fatalf("BenchmarkRecipe returned error: %v", err)
+	}
+
+	if result.Iterations != 50 {
+		t.Errorf("Iterations = %d, want 50", result.Iterations)
+	}
+
+	if result.Errors != 0 {
+		t.Errorf("Errors = %d, want 0", result.Errors)
+	}
+
+	if result.Min > result.Max {
+		t.Errorf("Min %v > Max %v", result.Min, result.Max)
+	}
+
+	if result.P50 > result.P99 {
+		t.Errorf("P50 %v > P99 %v", result.P50, result.P99)
+	}
+}
+
+func TestBenchmarkRecipe_NotFound(t *testing.T) {
+	orch := New()
+
+	_, err := orch.BenchmarkRecipe("missing", nil, nil)
+
+	if err == nil {
+		t.Fatal("Expected error for unregistered recipe")
+	}
+}
+
+func TestBenchmarkRecipe_CountsErrors(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("always fails")
+	})
+
+	result, err := orch.BenchmarkRecipe("fail", nil, &BenchmarkOptions{
+		Iterations: 10,
+		Warmup:     1,
+	})
+
+	if err != nil {
+		t.Fatalf("BenchmarkRecipe returned error: %v", err)
+	}
+
+	if result.Errors != 10 {
+		t.Errorf("Errors = %d, want 10", result.Errors)
+	}
+}
+
+func TestBenchmarkRecipe_Latency(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("sleepy", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(time.Millisecond)
+		return nil, nil
+	})
+
+	result, err := orch.BenchmarkRecipe("sleepy", nil, &BenchmarkOptions{
+		Iterations: 5,
+		Warmup:     1,
+	})
+
+	if err != nil {
+		t.Fatalf("BenchmarkRecipe returned error: %v", err)
+	}
+
+	if result.Min < time.Millisecond {
+		t.Errorf("Min = %v, want >= 1ms", result.Min)
+	}
+}
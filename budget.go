@@ -0,0 +1,137 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// Budget defines per-request resource limits enforced during recipe execution.
+// Enforcement is best-effort: the wall-clock budget is checked by a watchdog
+// timer, and the memory budget is estimated by periodically sampling the
+// process heap (runtime.ReadMemStats), so other concurrent requests can
+// inflate or mask the measured delta. Budgets are meant to protect shared
+// workers from pathological payloads, not for precise accounting.
+type Budget struct {
+	WallClock      time.Duration // Maximum wall-clock time for the request (0 = no limit)
+	MemoryBytes    uint64        // Maximum heap growth during the request (0 = no limit)
+	SampleInterval time.Duration // Heap sampling interval (default 10ms)
+}
+
+// budgetBreach records which budget, if any, was exceeded during execution.
+const (
+	breachNone int32 = iota
+	breachWallClock
+	breachMemory
+)
+
+// WithDefaultBudget sets a resource budget applied to every request.
+// Individual recipes can override it via RecipeOption.Budget.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithDefaultBudget(&relayer.Budget{
+//		WallClock:   2 * time.Second,
+//		MemoryBytes: 64 << 20, // 64 MiB heap growth
+//	}))
+func WithDefaultBudget(budget *Budget) Option {
+	return func(o *Orchestrator) {
+		o.defaultBudget = budget
+	}
+}
+
+// budgetFor returns the effective budget for a recipe, preferring the
+// per-recipe override and falling back to the orchestrator default.
+func (o *Orchestrator) budgetFor(recipe string) *Budget {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if opt, exists := o.recipeOptions[recipe]; exists && opt.Budget != nil {
+		return opt.Budget
+	}
+	return o.defaultBudget
+}
+
+// executeWithBudget runs safeExecute under a watchdog that cancels the
+// request context and records a breach when a budget is exceeded.
+// Like safeExecute, it returns the raw handler error alongside the response.
+func (o *Orchestrator) executeWithBudget(ctx context.Context, req SubRequest, budget *Budget) (Response, error) {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	interval := budget.SampleInterval
+	if interval <= 0 {
+		interval = 10 * time.Millisecond
+	}
+
+	var breach int32
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		var baseline runtime.MemStats
+		if budget.MemoryBytes > 0 {
+			runtime.ReadMemStats(&baseline)
+		}
+
+		var wallDeadline <-chan time.Time
+		if budget.WallClock > 0 {
+			timer := time.NewTimer(budget.WallClock)
+			defer timer.Stop()
+			wallDeadline = timer.C
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-wallDeadline:
+				atomic.StoreInt32(&breach, breachWallClock)
+				cancel()
+				return
+			case <-ticker.C:
+				if budget.MemoryBytes == 0 {
+					continue
+				}
+				var now runtime.MemStats
+				runtime.ReadMemStats(&now)
+				if now.HeapAlloc > baseline.HeapAlloc && now.HeapAlloc-baseline.HeapAlloc > budget.MemoryBytes {
+					atomic.StoreInt32(&breach, breachMemory)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	resp, rawErr := o.safeExecute(watchCtx, req)
+
+	switch atomic.LoadInt32(&breach) {
+	case breachWallClock:
+		return Response{
+			ID:     req.ID,
+			Status: 504,
+			Error: &Error{
+				Code:    ErrCodeBudgetExceeded,
+				Message: fmt.Sprintf("request exceeded wall-clock budget of %v", budget.WallClock),
+				Details: map[string]interface{}{"budget": "wall_clock"},
+			},
+		}, rawErr
+	case breachMemory:
+		return Response{
+			ID:     req.ID,
+			Status: 413,
+			Error: &Error{
+				Code:    ErrCodeBudgetExceeded,
+				Message: fmt.Sprintf("request exceeded memory budget of %d bytes", budget.MemoryBytes),
+				Details: map[string]interface{}{"budget": "memory"},
+			},
+		}, rawErr
+	}
+
+	return resp, rawErr
+}
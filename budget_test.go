@@ -0,0 +1,81 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBudget_WallClockExceeded(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		select {
+		case <-time.After(500 * time.Millisecond):
+			return "done", nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}, &RecipeOption{
+		Budget: &Budget{WallClock: 20 * time.Millisecond},
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "slow"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if results[0].Status != 504 {
+		t.Errorf("Status = %d, want 504", results[0].Status)
+	}
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeBudgetExceeded {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodeBudgetExceeded)
+	}
+
+	if results[0].Error.Details["budget"] != "wall_clock" {
+		t.Errorf("Details budget = %v, want wall_clock", results[0].Error.Details["budget"])
+	}
+}
+
+func TestBudget_WithinBudget(t *testing.T) {
+	orch := New(WithDefaultBudget(&Budget{WallClock: time.Second}))
+
+	orch.RegisterRecipe("fast", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "fast"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
+
+func TestBudget_RecipeOverridesDefault(t *testing.T) {
+	orch := New(WithDefaultBudget(&Budget{WallClock: 10 * time.Millisecond}))
+
+	// Per-recipe budget is more generous than the default; the request
+	// should succeed even though it exceeds the default wall-clock budget.
+	orch.RegisterRecipe("medium", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(30 * time.Millisecond)
+		return "ok", nil
+	}, &RecipeOption{
+		Budget: &Budget{WallClock: time.Second},
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "medium"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 (recipe budget should override default)", results[0].Status)
+	}
+}
@@ -0,0 +1,140 @@
+package relayer
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is the pluggable storage interface for the result cache.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached response for a key, if present and fresh.
+	Get(key string) (Response, bool)
+
+	// Set stores a response under a key with the given time-to-live.
+	Set(key string, resp Response, ttl time.Duration)
+
+	// Delete removes a key, if present.
+	Delete(key string)
+}
+
+// WithResultCache serves idempotent recipes from cache, keyed by tenant,
+// recipe, and payload hash (DefaultDedupKey). Only successful (2xx)
+// responses are cached; errors always re-execute. Cached responses keep
+// the original Data but carry the current request's ID and tenant.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithResultCache(relayer.NewLRUCache(1024), time.Minute))
+func WithResultCache(cache Cache, ttl time.Duration) Option {
+	return func(o *Orchestrator) {
+		if cache == nil {
+			panic("result cache cannot be nil")
+		}
+		if ttl <= 0 {
+			panic("result cache TTL must be positive")
+		}
+		o.resultCache = cache
+		o.resultCacheTTL = ttl
+	}
+}
+
+// LRUCache is an in-memory, size-bounded Cache with per-entry TTL.
+// Least-recently-used entries are evicted when capacity is exceeded;
+// expired entries are evicted lazily on access.
+type LRUCache struct {
+	capacity int
+
+	mu    sync.Mutex
+	order *list.List               // Front = most recently used
+	items map[string]*list.Element // key -> element in order
+}
+
+type lruEntry struct {
+	key       string
+	resp      Response
+	expiresAt time.Time
+}
+
+// NewLRUCache creates an LRU result cache holding at most capacity
+// entries. Panics if capacity is < 1.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		panic("LRU cache capacity must be at least 1")
+	}
+	return &LRUCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached response for a key, if present and unexpired.
+func (c *LRUCache) Get(key string) (Response, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, exists := c.items[key]
+	if !exists {
+		return Response{}, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return Response{}, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.resp, true
+}
+
+// Set stores a response, evicting the least-recently-used entry when the
+// cache is full.
+func (c *LRUCache) Set(key string, resp Response, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, exists := c.items[key]; exists {
+		entry := elem.Value.(*lruEntry)
+		entry.resp = resp
+		entry.expiresAt = time.Now().Add(ttl)
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry{
+		key:       key,
+		resp:      resp,
+		expiresAt: time.Now().Add(ttl),
+	})
+}
+
+// Delete removes a key, if present.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, exists := c.items[key]; exists {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries currently cached (including entries
+// that have expired but not yet been evicted).
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
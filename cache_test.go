@@ -0,0 +1,125 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCache_Basic(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("a", Response{ID: "1", Status: 200}, time.Minute)
+
+	resp, hit := cache.Get("a")
+	if !hit || resp.Status != 200 {
+		t.Errorf("Get(a) = %+v, %v; want hit with 200", resp, hit)
+	}
+
+	if _, hit := cache.Get("missing"); hit {
+		t.Error("Get(missing) reported a hit")
+	}
+
+	cache.Delete("a")
+	if _, hit := cache.Get("a"); hit {
+		t.Error("Get(a) hit after Delete")
+	}
+}
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("a", Response{ID: "a"}, time.Minute)
+	cache.Set("b", Response{ID: "b"}, time.Minute)
+
+	// Touch "a" so "b" becomes the eviction candidate.
+	cache.Get("a")
+	cache.Set("c", Response{ID: "c"}, time.Minute)
+
+	if _, hit := cache.Get("b"); hit {
+		t.Error("Expected b to be evicted")
+	}
+	if _, hit := cache.Get("a"); !hit {
+		t.Error("Expected a to survive eviction")
+	}
+}
+
+func TestLRUCache_TTLExpiry(t *testing.T) {
+	cache := NewLRUCache(10)
+
+	cache.Set("a", Response{ID: "a"}, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if _, hit := cache.Get("a"); hit {
+		t.Error("Expected expired entry to miss")
+	}
+}
+
+func TestResultCache_ServesRepeatRequests(t *testing.T) {
+	orch := New(WithResultCache(NewLRUCache(16), time.Minute))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	batch := []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hi"}}
+
+	first := orch.ExecuteBatch(context.Background(), batch)
+	second := orch.ExecuteBatch(context.Background(), batch)
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1 (second should be cached)", n)
+	}
+
+	if first[0].Data != "hi" || second[0].Data != "hi" {
+		t.Errorf("Data = %v / %v, want hi / hi", first[0].Data, second[0].Data)
+	}
+}
+
+func TestResultCache_ErrorsNotCached(t *testing.T) {
+	orch := New(WithResultCache(NewLRUCache(16), time.Minute))
+
+	var calls int32
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errors.New("nope")
+	})
+
+	batch := []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "fail"}}
+
+	orch.ExecuteBatch(context.Background(), batch)
+	orch.ExecuteBatch(context.Background(), batch)
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Handler called %d times, want 2 (errors must not be cached)", n)
+	}
+}
+
+func TestResultCache_TenantsDoNotShareEntries(t *testing.T) {
+	orch := New(WithResultCache(NewLRUCache(16), time.Minute))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "x"},
+	})
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo", Payload: "x"},
+	})
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Handler called %d times, want 2 (cache must be tenant-scoped)", n)
+	}
+
+	if results[0].TenantID != "tenant-b" {
+		t.Errorf("TenantID = %s, want tenant-b", results[0].TenantID)
+	}
+}
@@ -0,0 +1,97 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithChaos_TargetsOnlySelectedRecipe(t *testing.T) {
+	orch := New(WithChaos(ChaosConfig{
+		FaultInjectionConfig: FaultInjectionConfig{ErrorProbability: 1.0, Seed: 1},
+		Recipes:              []string{"flaky"},
+	}))
+	echo := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	orch.RegisterRecipe("flaky", echo)
+	orch.RegisterRecipe("stable", echo)
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "flaky"},
+		{ID: "2", TenantID: "t", Recipe: "stable"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("flaky Status = %d, want 500 from injected error", results[0].Status)
+	}
+	if results[1].Status != 200 || results[1].Data != "ok" {
+		t.Errorf("stable result = %d/%v, want untouched 200/ok", results[1].Status, results[1].Data)
+	}
+}
+
+func TestWithChaos_TargetsOnlySelectedTenant(t *testing.T) {
+	orch := New(WithChaos(ChaosConfig{
+		FaultInjectionConfig: FaultInjectionConfig{ErrorProbability: 1.0, Seed: 1},
+		Tenants:              []string{"tenant-canary"},
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-canary", Recipe: "echo"},
+		{ID: "2", TenantID: "tenant-prod", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("canary Status = %d, want 500 from injected error", results[0].Status)
+	}
+	if results[1].Status != 200 {
+		t.Errorf("prod Status = %d, want untouched 200", results[1].Status)
+	}
+}
+
+func TestWithChaos_InjectedPanicsRecover(t *testing.T) {
+	orch := New(WithChaos(ChaosConfig{
+		FaultInjectionConfig: FaultInjectionConfig{PanicProbability: 1.0, Seed: 1},
+		Recipes:              []string{"echo"},
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500 from injected panic", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodePanic {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodePanic)
+	}
+}
+
+func TestWithChaos_EmptySelectorsCoverAllTraffic(t *testing.T) {
+	orch := New(WithChaos(ChaosConfig{
+		FaultInjectionConfig: FaultInjectionConfig{ErrorProbability: 1.0, Seed: 1},
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "any", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500 with no selectors set", results[0].Status)
+	}
+}
+
+func TestWithChaos_DisabledByDefault(t *testing.T) {
+	orch := New()
+	if orch.chaos != nil {
+		t.Error("chaos injector set without WithChaos")
+	}
+}
@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClientDeadline_SurfacedAsClientTimeout(t *testing.T) {
+	orch := New(WithTimeout(time.Second))
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	results := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Status != 504 || results[0].Error.Code != ErrCodeClientTimeout {
+		t.Errorf("got (%d, %v), want a 504 CLIENT_TIMEOUT for a caller-imposed deadline",
+			results[0].Status, results[0].Error)
+	}
+}
+
+func TestRecipeTimeout_StillReportedAsTimeout(t *testing.T) {
+	orch := New(WithTimeout(20 * time.Millisecond))
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeTimeout {
+		t.Errorf("Error = %v, want TIMEOUT when the recipe timeout is the constraint", results[0].Error)
+	}
+}
+
+func TestWithTimeoutBudget_SlicesDeadlineAcrossRetries(t *testing.T) {
+	orch := New(WithTimeout(time.Second), WithTimeoutBudget())
+
+	var remaining []time.Duration
+	orch.RegisterRecipe("flaky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if deadline, ok := ctx.Deadline(); ok {
+			remaining = append(remaining, time.Until(deadline))
+		}
+		return nil, errors.New("transient")
+	}, &RecipeOption{MaxRetries: 2, Backoff: time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	orch.ExecuteBatch(ctx, []SubRequest{{ID: "1", TenantID: "t", Recipe: "flaky"}})
+
+	if len(remaining) != 3 {
+		t.Fatalf("handler ran %d times, want 3", len(remaining))
+	}
+	// With a 300ms budget over 3 attempts the first attempt gets roughly
+	// a third, the second roughly half of what's left, and the final
+	// attempt whatever remains — so early attempts must not see the full
+	// window.
+	if remaining[0] > 150*time.Millisecond {
+		t.Errorf("first attempt saw %v of deadline, want roughly a third of 300ms", remaining[0])
+	}
+	if remaining[1] > 200*time.Millisecond {
+		t.Errorf("second attempt saw %v of deadline, want roughly half the remainder", remaining[1])
+	}
+}
+
+func TestWithTimeoutBudget_NoDeadlineLeavesTimeoutsAlone(t *testing.T) {
+	orch := New(WithTimeout(50*time.Millisecond), WithTimeoutBudget())
+
+	calls := 0
+	orch.RegisterRecipe("flaky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		calls++
+		if calls < 2 {
+			return nil, errors.New("transient")
+		}
+		return "ok", nil
+	}, &RecipeOption{MaxRetries: 2, Backoff: time.Millisecond})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "flaky"},
+	})
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200: budgeting must not interfere without a deadline", results[0].Status)
+	}
+}
@@ -0,0 +1,68 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts time measurement and timeout scheduling so tests can
+// drive timeout behavior deterministically instead of sleeping. The
+// default implementation delegates to the time package.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that delivers the time after duration d.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the production Clock backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// WithClock overrides the clock used for request durations and recipe
+// timeouts. This is a testing extension point — pair it with the fake
+// clock in the relayertest package to test timeout behavior without real
+// sleeps. Panics if clock is nil.
+//
+// Example:
+//
+//	clock := relayertest.NewFakeClock(time.Now())
+//	orch := relayer.New(relayer.WithClock(clock))
+func WithClock(clock Clock) Option {
+	return func(o *Orchestrator) {
+		if clock == nil {
+			panic("clock cannot be nil")
+		}
+		o.clock = clock
+	}
+}
+
+// withTimeout applies the recipe timeout to ctx using the configured
+// clock. With the default clock this is context.WithTimeout; a custom
+// clock drives cancellation through Clock.After so fake clocks can
+// trigger timeouts instantly. Either way the returned context reports
+// context.DeadlineExceeded as its cause on timeout.
+func (o *Orchestrator) withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, isReal := o.clock.(realClock); isReal {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	expired := o.clock.After(timeout)
+	go func() {
+		select {
+		case <-expired:
+			cancelCause(context.DeadlineExceeded)
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() { cancelCause(context.Canceled) }
+}
+
+// timedOut reports whether ctx was cancelled by a timeout, regardless of
+// which clock drove the cancellation.
+func timedOut(ctx context.Context) bool {
+	return ctx.Err() == context.DeadlineExceeded || context.Cause(ctx) == context.DeadlineExceeded
+}
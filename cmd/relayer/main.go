@@ -0,0 +1,324 @@
+// Command relayer executes a batch of requests from the command line.
+// It reads a batch as a JSON array or NDJSON from a file or stdin,
+// executes it against an orchestrator configured from a declarative
+// config file and/or recipe plugins, and prints the results as a table
+// or JSON. Useful for ops runbooks and local testing.
+//
+// Usage:
+//
+//	relayer -input batch.json -format table
+//	cat batch.ndjson | relayer -config recipes.json -format json
+//	relayer -plugin ./myrecipes.so -input batch.json
+//
+// The config file declares recipes built from the built-in handler
+// kinds (echo, uppercase, sleep, fail):
+//
+//	{
+//	  "recipes": [
+//	    {"name": "ping", "kind": "echo", "timeout_ms": 500},
+//	    {"name": "slow", "kind": "sleep", "param": "100ms"}
+//	  ]
+//	}
+//
+// A plugin is a Go plugin exporting
+// RegisterRecipes(*relayer.Orchestrator); it is loaded before the batch
+// runs so plugins can provide real recipe implementations.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"plugin"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "relayer: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var (
+		configPath  = flag.String("config", "", "declarative recipe config file (JSON)")
+		pluginPath  = flag.String("plugin", "", "recipe plugin (.so) exporting RegisterRecipes")
+		inputPath   = flag.String("input", "-", "batch file (JSON array or NDJSON), or - for stdin")
+		format      = flag.String("format", "table", "output format: table or json")
+		timeout     = flag.Duration("timeout", 5*time.Second, "default recipe timeout")
+		concurrency = flag.Int("concurrency", 0, "max concurrent executions (0 = unlimited)")
+	)
+	flag.Parse()
+
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown format %q (want table or json)", *format)
+	}
+
+	orch := relayer.New(
+		relayer.WithTimeout(*timeout),
+		relayer.WithMaxConcurrency(*concurrency),
+	)
+
+	if *configPath != "" {
+		if err := loadConfig(orch, *configPath); err != nil {
+			return fmt.Errorf("load config: %w", err)
+		}
+	}
+	if *pluginPath != "" {
+		if err := loadPlugin(orch, *pluginPath); err != nil {
+			return fmt.Errorf("load plugin: %w", err)
+		}
+	}
+	if *configPath == "" && *pluginPath == "" {
+		registerBuiltins(orch)
+	}
+
+	batch, err := readBatch(*inputPath)
+	if err != nil {
+		return fmt.Errorf("read batch: %w", err)
+	}
+	if len(batch) == 0 {
+		return fmt.Errorf("batch is empty")
+	}
+
+	result := orch.ExecuteBatchResult(context.Background(), batch)
+
+	if *format == "json" {
+		return printJSON(os.Stdout, result)
+	}
+	return printTable(os.Stdout, result)
+}
+
+// config is the declarative recipe configuration format.
+type config struct {
+	Recipes []recipeConfig `json:"recipes"`
+}
+
+type recipeConfig struct {
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	Param     string `json:"param,omitempty"`
+	TimeoutMs int    `json:"timeout_ms,omitempty"`
+}
+
+func loadConfig(orch *relayer.Orchestrator, path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return err
+	}
+
+	for _, rc := range cfg.Recipes {
+		handler, err := builtinHandler(rc.Kind, rc.Param)
+		if err != nil {
+			return fmt.Errorf("recipe %q: %w", rc.Name, err)
+		}
+
+		var opts []*relayer.RecipeOption
+		if rc.TimeoutMs > 0 {
+			opts = append(opts, &relayer.RecipeOption{
+				Timeout: time.Duration(rc.TimeoutMs) * time.Millisecond,
+			})
+		}
+		orch.RegisterRecipe(rc.Name, handler, opts...)
+	}
+	return nil
+}
+
+func loadPlugin(orch *relayer.Orchestrator, path string) error {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return err
+	}
+
+	sym, err := p.Lookup("RegisterRecipes")
+	if err != nil {
+		return err
+	}
+
+	register, ok := sym.(func(*relayer.Orchestrator))
+	if !ok {
+		return fmt.Errorf("RegisterRecipes has type %T, want func(*relayer.Orchestrator)", sym)
+	}
+
+	register(orch)
+	return nil
+}
+
+// builtinHandler returns one of the built-in handler kinds used by
+// declarative configs and local testing.
+func builtinHandler(kind, param string) (relayer.Handler, error) {
+	switch kind {
+	case "echo":
+		return func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return payload, nil
+		}, nil
+	case "uppercase":
+		return func(ctx context.Context, payload interface{}) (interface{}, error) {
+			str, ok := payload.(string)
+			if !ok {
+				return nil, fmt.Errorf("payload must be string")
+			}
+			return strings.ToUpper(str), nil
+		}, nil
+	case "sleep":
+		d, err := time.ParseDuration(param)
+		if err != nil {
+			return nil, fmt.Errorf("sleep param: %w", err)
+		}
+		return func(ctx context.Context, payload interface{}) (interface{}, error) {
+			select {
+			case <-time.After(d):
+				return payload, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}, nil
+	case "fail":
+		return func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return nil, fmt.Errorf("%s", param)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+}
+
+func registerBuiltins(orch *relayer.Orchestrator) {
+	echo, _ := builtinHandler("echo", "")
+	upper, _ := builtinHandler("uppercase", "")
+	orch.RegisterRecipe("echo", echo)
+	orch.RegisterRecipe("uppercase", upper)
+}
+
+// readBatch reads a batch from path ("-" for stdin), accepting either a
+// JSON array or newline-delimited JSON objects.
+func readBatch(path string) ([]relayer.SubRequest, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	decoder := json.NewDecoder(r)
+
+	// Peek at the first token to distinguish an array from NDJSON.
+	tok, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []relayer.SubRequest
+	if delim, ok := tok.(json.Delim); ok && delim == '[' {
+		for decoder.More() {
+			var req relayer.SubRequest
+			if err := decoder.Decode(&req); err != nil {
+				return nil, err
+			}
+			batch = append(batch, req)
+		}
+		return batch, nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("input must be a JSON array or NDJSON objects")
+	}
+
+	// NDJSON: re-decode the first object's fields, then the rest.
+	var first relayer.SubRequest
+	if err := decodeObjectFields(decoder, &first); err != nil {
+		return nil, err
+	}
+	batch = append(batch, first)
+
+	for decoder.More() {
+		var req relayer.SubRequest
+		if err := decoder.Decode(&req); err != nil {
+			return nil, err
+		}
+		batch = append(batch, req)
+	}
+	return batch, nil
+}
+
+// decodeObjectFields consumes the remainder of an object whose opening
+// brace was already read, populating req from its fields.
+func decodeObjectFields(decoder *json.Decoder, req *relayer.SubRequest) error {
+	fields := map[string]json.RawMessage{}
+	for decoder.More() {
+		keyTok, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := keyTok.(string)
+		if !ok {
+			return fmt.Errorf("malformed NDJSON object")
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		fields[key] = value
+	}
+	// Consume the closing brace
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, req)
+}
+
+func printJSON(w io.Writer, result relayer.BatchResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+func printTable(w io.Writer, result relayer.BatchResult) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTENANT\tSTATUS\tDURATION\tRESULT")
+
+	for _, resp := range result.Responses {
+		outcome := ""
+		if resp.Error != nil {
+			outcome = fmt.Sprintf("%s: %s", resp.Error.Code, resp.Error.Message)
+		} else if resp.Data != nil {
+			raw, err := json.Marshal(resp.Data)
+			if err != nil {
+				outcome = fmt.Sprintf("%v", resp.Data)
+			} else {
+				outcome = string(raw)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\n",
+			resp.ID, resp.TenantID, resp.Status, resp.Duration.Round(time.Microsecond), outcome)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d total, %d succeeded, %d failed in %s\n",
+		result.Summary.Total, result.Summary.Successes, result.Summary.Failures,
+		result.Summary.Duration.Round(time.Millisecond))
+	return err
+}
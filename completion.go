@@ -0,0 +1,53 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+)
+
+// IndexedResponse pairs a Response with the index of its request in the
+// original batch, for callers consuming results in completion order.
+type IndexedResponse struct {
+	// Index is the position of the originating request in the input
+	// batch.
+	Index int
+	Response
+}
+
+// ExecuteBatchCompletionOrder processes a batch concurrently and returns
+// results ordered by completion time — fastest first — instead of input
+// order. Each result carries the original batch index so latency tooling
+// can correlate finish order with submission order. Blocks until the
+// whole batch has completed; use ExecuteBatchStream to consume results
+// as they arrive.
+func (o *Orchestrator) ExecuteBatchCompletionOrder(ctx context.Context, batch []SubRequest) []IndexedResponse {
+	if rejected, ok := o.admitBatch(batch); !ok {
+		results := make([]IndexedResponse, len(batch))
+		for i, resp := range rejected {
+			results[i] = IndexedResponse{Index: i, Response: resp}
+		}
+		return results
+	}
+
+	ctx = ensureBatchID(ctx)
+
+	results := make([]IndexedResponse, 0, len(batch))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i, req := range batch {
+		wg.Add(1)
+		i, req := i, req
+		o.scheduler.Schedule(func() {
+			defer wg.Done()
+			var resp Response
+			o.processRequest(ctx, req, &resp)
+			mu.Lock()
+			results = append(results, IndexedResponse{Index: i, Response: resp})
+			mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,49 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExecuteBatchCompletionOrder_FastFinishesFirst(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("sleep", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(payload.(time.Duration))
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatchCompletionOrder(context.Background(), []SubRequest{
+		{ID: "slow", TenantID: "t", Recipe: "sleep", Payload: 100 * time.Millisecond},
+		{ID: "fast", TenantID: "t", Recipe: "sleep", Payload: time.Millisecond},
+	})
+
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+	if results[0].ID != "fast" || results[0].Index != 1 {
+		t.Errorf("first completion = (%q, %d), want (fast, 1)", results[0].ID, results[0].Index)
+	}
+	if results[1].ID != "slow" || results[1].Index != 0 {
+		t.Errorf("second completion = (%q, %d), want (slow, 0)", results[1].ID, results[1].Index)
+	}
+}
+
+func TestExecuteBatchCompletionOrder_OversizedBatch(t *testing.T) {
+	orch := New(WithMaxBatchSize(1))
+
+	results := orch.ExecuteBatchCompletionOrder(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "x"},
+		{ID: "2", TenantID: "t", Recipe: "x"},
+	})
+
+	for i, r := range results {
+		if r.Status != 413 {
+			t.Errorf("results[%d].Status = %d, want 413", i, r.Status)
+		}
+		if r.Index != i {
+			t.Errorf("results[%d].Index = %d, want %d", i, r.Index, i)
+		}
+	}
+}
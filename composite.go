@@ -0,0 +1,55 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// CompositeExecutionHook fans OnStart and OnComplete out to multiple
+// hooks in registration order. Create one with NewCompositeExecutionHook
+// or let WithExecutionHooks build it for you.
+type CompositeExecutionHook struct {
+	hooks []ExecutionHook
+}
+
+// NewCompositeExecutionHook combines multiple execution hooks into one.
+// Nil hooks are skipped.
+//
+// Example:
+//
+//	hook := relayer.NewCompositeExecutionHook(&LoggingHook{}, metricsHook)
+//	orch := relayer.New(relayer.WithExecutionHook(hook))
+func NewCompositeExecutionHook(hooks ...ExecutionHook) *CompositeExecutionHook {
+	kept := make([]ExecutionHook, 0, len(hooks))
+	for _, h := range hooks {
+		if h != nil {
+			kept = append(kept, h)
+		}
+	}
+	return &CompositeExecutionHook{hooks: kept}
+}
+
+// OnStart invokes OnStart on each hook in order.
+func (c *CompositeExecutionHook) OnStart(ctx context.Context, req SubRequest) {
+	for _, h := range c.hooks {
+		h.OnStart(ctx, req)
+	}
+}
+
+// OnComplete invokes OnComplete on each hook in order.
+func (c *CompositeExecutionHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	for _, h := range c.hooks {
+		h.OnComplete(ctx, req, resp, duration)
+	}
+}
+
+// WithExecutionHooks sets multiple execution hooks at once, fanning
+// lifecycle events out to each in order. It is shorthand for
+// WithExecutionHook(NewCompositeExecutionHook(hooks...)).
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithExecutionHooks(&LoggingHook{}, metricsHook))
+func WithExecutionHooks(hooks ...ExecutionHook) Option {
+	return WithExecutionHook(NewCompositeExecutionHook(hooks...))
+}
@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func conditionalTestOrch() *Orchestrator {
+	orch := New()
+	orch.RegisterRecipe("find-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		id := payload.(map[string]interface{})["id"].(string)
+		if id == "missing" {
+			return nil, &Error{Code: ErrCodeRecipeNotFound, Message: "no such user"}
+		}
+		return map[string]interface{}{"id": id, "status": 200}, nil
+	})
+	orch.RegisterRecipe("create-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "created", nil
+	})
+	return orch
+}
+
+func TestConditional_WhenErrorCodeTakesThenBranch(t *testing.T) {
+	orch := conditionalTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"upsert-user": {"conditional": {
+			"if":   "find-user",
+			"when": {"error_code": "RECIPE_NOT_FOUND"},
+			"then": "create-user"
+		}}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "upsert-user",
+			Payload: map[string]interface{}{"id": "missing"}},
+		{ID: "2", TenantID: "t", Recipe: "upsert-user",
+			Payload: map[string]interface{}{"id": "u-1"}},
+	})
+
+	if results[0].Data != "created" {
+		t.Errorf("missing user Data = %v, want created via the then branch", results[0].Data)
+	}
+	if results[0].Meta == nil || len(results[0].Meta["branches"].([]interface{})) != 1 ||
+		results[0].Meta["branches"].([]interface{})[0] != "then" {
+		t.Errorf("Meta = %v, want branches [then]", results[0].Meta)
+	}
+
+	found, ok := results[1].Data.(map[string]interface{})
+	if !ok || found["id"] != "u-1" {
+		t.Errorf("existing user Data = %v, want the find-user output passed through", results[1].Data)
+	}
+	if results[1].Meta["branches"].([]interface{})[0] != "else" {
+		t.Errorf("Meta = %v, want branches [else]", results[1].Meta)
+	}
+}
+
+func TestConditional_WhenFieldEquals(t *testing.T) {
+	orch := conditionalTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"check": {"conditional": {
+			"if":   "find-user",
+			"when": {"field": "status", "equals": 200},
+			"then": "create-user",
+			"else": "find-user"
+		}}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "check",
+			Payload: map[string]interface{}{"id": "u-1"}},
+	})
+	// status == 200 matched (int output vs JSON float literal), so the
+	// then branch ran.
+	if results[0].Data != "created" {
+		t.Errorf("Data = %v, want created", results[0].Data)
+	}
+}
+
+func TestConditional_BranchRecordedInErrorDetails(t *testing.T) {
+	orch := conditionalTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"doomed": {"conditional": {
+			"if":   "find-user",
+			"when": {"error_code": "RECIPE_NOT_FOUND"},
+			"then": "ghost-recipe"
+		}}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "doomed",
+			Payload: map[string]interface{}{"id": "missing"}},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500 from the missing then recipe", results[0].Status)
+	}
+	branches, _ := results[0].Error.Details["branches"].([]interface{})
+	if len(branches) != 1 || branches[0] != "then" {
+		t.Errorf("Error.Details = %v, want branches [then]", results[0].Error.Details)
+	}
+}
+
+func TestStepPredicate_ValidationRejectsAmbiguousForms(t *testing.T) {
+	orch := New()
+	_, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"bad": {"conditional": {
+			"if":   "x",
+			"when": {"equals": 1, "error_code": "TIMEOUT"}
+		}}
+	}`))
+	if err == nil {
+		t.Error("LoadRecipeConfig() succeeded with an ambiguous predicate, want error")
+	}
+}
@@ -11,11 +11,101 @@ const (
 	tenantIDKey contextKey = iota
 	requestIDKey
 	recipeNameKey
+	requestInfoKey
+	batchIDKey
 )
 
+// RequestInfo carries the per-request metadata the orchestrator injects
+// into recipe contexts. Storing it as one value under a single key costs
+// one allocation per request instead of three chained context.WithValue
+// calls.
+type RequestInfo struct {
+	TenantID   string
+	RequestID  string
+	RecipeName string
+	TraceID    string
+	BatchID    string
+}
+
+// WithRequestInfo returns a new context with all request metadata
+// embedded under a single key. This is what the orchestrator uses on the
+// hot path; the individual With* functions below remain for callers that
+// set values piecemeal.
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey, info)
+}
+
+// RequestInfoFromContext extracts the request metadata from the context.
+// Returns the info and true if present, or a zero RequestInfo and false
+// if not.
+//
+// Example usage in a recipe:
+//
+//	func MyRecipe(ctx context.Context, payload interface{}) (interface{}, error) {
+//		info, _ := relayer.RequestInfoFromContext(ctx)
+//		log.Printf("request %s for tenant %s", info.RequestID, info.TenantID)
+//		return result, nil
+//	}
+func RequestInfoFromContext(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey).(RequestInfo)
+	return info, ok
+}
+
+// TraceID extracts the trace/correlation ID from the context.
+// Returns the trace ID and true if present, or empty string and false if
+// not. Every executed request has one: the orchestrator generates a
+// trace ID when the SubRequest doesn't carry one.
+//
+// Useful for correlating recipe logs with hook and downstream output:
+//
+//	func MyRecipe(ctx context.Context, payload interface{}) (interface{}, error) {
+//		traceID, _ := relayer.TraceID(ctx)
+//		log.Printf("[%s] fetching user", traceID)
+//		return result, nil
+//	}
+func TraceID(ctx context.Context) (string, bool) {
+	if info, ok := RequestInfoFromContext(ctx); ok && info.TraceID != "" {
+		return info.TraceID, true
+	}
+	return "", false
+}
+
+// WithBatchID returns a new context carrying a caller-supplied batch ID.
+// Execution entry points generate one when the incoming context has
+// none, so every sub-request of a batch shares the same ID.
+func WithBatchID(ctx context.Context, batchID string) context.Context {
+	return context.WithValue(ctx, batchIDKey, batchID)
+}
+
+// BatchID extracts the batch ID from the context. Returns the ID and
+// true if present, or empty string and false if not. Available inside
+// handlers and hooks for correlating logs from sub-requests of the same
+// batch:
+//
+//	func MyRecipe(ctx context.Context, payload interface{}) (interface{}, error) {
+//		batchID, _ := relayer.BatchID(ctx)
+//		log.Printf("[batch %s] processing", batchID)
+//		return result, nil
+//	}
+func BatchID(ctx context.Context) (string, bool) {
+	if info, ok := RequestInfoFromContext(ctx); ok && info.BatchID != "" {
+		return info.BatchID, true
+	}
+	batchID, ok := ctx.Value(batchIDKey).(string)
+	return batchID, ok
+}
+
+// ensureBatchID returns ctx carrying a batch ID, generating one when the
+// caller didn't supply one via WithBatchID.
+func ensureBatchID(ctx context.Context) context.Context {
+	if _, ok := BatchID(ctx); ok {
+		return ctx
+	}
+	return WithBatchID(ctx, newBatchID())
+}
+
 // WithTenantID returns a new context with the tenant ID embedded.
-// This is used internally by the orchestrator to inject tenant isolation
-// into recipe contexts.
+// Prefer WithRequestInfo when setting all request metadata at once.
 func WithTenantID(ctx context.Context, tenantID string) context.Context {
 	return context.WithValue(ctx, tenantIDKey, tenantID)
 }
@@ -34,13 +124,15 @@ func WithTenantID(ctx context.Context, tenantID string) context.Context {
 //		return result, nil
 //	}
 func TenantID(ctx context.Context) (string, bool) {
+	if info, ok := RequestInfoFromContext(ctx); ok {
+		return info.TenantID, true
+	}
 	tenantID, ok := ctx.Value(tenantIDKey).(string)
 	return tenantID, ok
 }
 
 // WithRequestID returns a new context with the request ID embedded.
-// This is used internally by the orchestrator to track individual requests
-// through their lifecycle.
+// Prefer WithRequestInfo when setting all request metadata at once.
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, requestIDKey, requestID)
 }
@@ -56,13 +148,15 @@ func WithRequestID(ctx context.Context, requestID string) context.Context {
 //		return result, nil
 //	}
 func RequestID(ctx context.Context) (string, bool) {
+	if info, ok := RequestInfoFromContext(ctx); ok {
+		return info.RequestID, true
+	}
 	requestID, ok := ctx.Value(requestIDKey).(string)
 	return requestID, ok
 }
 
 // WithRecipeName returns a new context with the recipe name embedded.
-// This is used internally by the orchestrator to track which recipe
-// is being executed.
+// Prefer WithRequestInfo when setting all request metadata at once.
 func WithRecipeName(ctx context.Context, recipeName string) context.Context {
 	return context.WithValue(ctx, recipeNameKey, recipeName)
 }
@@ -77,6 +171,9 @@ func WithRecipeName(ctx context.Context, recipeName string) context.Context {
 //		log.Printf("Starting recipe: %s", recipeName)
 //	}
 func RecipeName(ctx context.Context) (string, bool) {
+	if info, ok := RequestInfoFromContext(ctx); ok {
+		return info.RecipeName, true
+	}
 	recipeName, ok := ctx.Value(recipeNameKey).(string)
 	return recipeName, ok
 }
@@ -128,3 +128,57 @@ func TestContextPreservesParentValues(t *testing.T) {
 		t.Errorf("Child context missing request ID: got %q, %v", requestID, ok)
 	}
 }
+
+func TestRequestInfoFromContext(t *testing.T) {
+	ctx := WithRequestInfo(context.Background(), RequestInfo{
+		TenantID:   "tenant-1",
+		RequestID:  "req-1",
+		RecipeName: "get-user",
+	})
+
+	info, ok := RequestInfoFromContext(ctx)
+	if !ok {
+		t.Fatal("RequestInfoFromContext should find injected info")
+	}
+	if info.TenantID != "tenant-1" || info.RequestID != "req-1" || info.RecipeName != "get-user" {
+		t.Errorf("info = %+v, want injected values", info)
+	}
+
+	if _, ok := RequestInfoFromContext(context.Background()); ok {
+		t.Error("RequestInfoFromContext on empty context should return false")
+	}
+}
+
+func TestLegacyAccessors_ReadRequestInfo(t *testing.T) {
+	ctx := WithRequestInfo(context.Background(), RequestInfo{
+		TenantID:   "tenant-1",
+		RequestID:  "req-1",
+		RecipeName: "get-user",
+	})
+
+	if tenantID, ok := TenantID(ctx); !ok || tenantID != "tenant-1" {
+		t.Errorf("TenantID = %q/%v, want tenant-1/true", tenantID, ok)
+	}
+	if requestID, ok := RequestID(ctx); !ok || requestID != "req-1" {
+		t.Errorf("RequestID = %q/%v, want req-1/true", requestID, ok)
+	}
+	if recipe, ok := RecipeName(ctx); !ok || recipe != "get-user" {
+		t.Errorf("RecipeName = %q/%v, want get-user/true", recipe, ok)
+	}
+}
+
+func TestLegacyAccessors_FallBackToIndividualKeys(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "tenant-legacy")
+	ctx = WithRequestID(ctx, "req-legacy")
+	ctx = WithRecipeName(ctx, "recipe-legacy")
+
+	if tenantID, ok := TenantID(ctx); !ok || tenantID != "tenant-legacy" {
+		t.Errorf("TenantID = %q/%v, want legacy value", tenantID, ok)
+	}
+	if requestID, ok := RequestID(ctx); !ok || requestID != "req-legacy" {
+		t.Errorf("RequestID = %q/%v, want legacy value", requestID, ok)
+	}
+	if recipe, ok := RecipeName(ctx); !ok || recipe != "recipe-legacy" {
+		t.Errorf("RecipeName = %q/%v, want legacy value", recipe, ok)
+	}
+}
@@ -0,0 +1,305 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OverlapPolicy controls what a recurring schedule does when its next
+// tick arrives while the previous run is still executing.
+type OverlapPolicy int
+
+const (
+	// OverlapSkip drops the tick; the batch runs again at the next
+	// scheduled time. This is the default.
+	OverlapSkip OverlapPolicy = iota
+	// OverlapAllow starts the new run concurrently with the old one.
+	OverlapAllow
+)
+
+// RecurringOption configures a recurring schedule created by
+// ScheduleRecurring.
+type RecurringOption func(*RecurringBatch)
+
+// WithRecurringJitter delays each run by a random duration in [0, d),
+// spreading load when many instances share a schedule. Panics if d is
+// negative.
+func WithRecurringJitter(d time.Duration) RecurringOption {
+	return func(r *RecurringBatch) {
+		if d < 0 {
+			panic("jitter cannot be negative")
+		}
+		r.jitter = d
+	}
+}
+
+// WithOverlapPolicy sets what happens when a tick fires while the
+// previous run is still executing.
+func WithOverlapPolicy(p OverlapPolicy) RecurringOption {
+	return func(r *RecurringBatch) {
+		r.overlap = p
+	}
+}
+
+// RecurringBatch is a handle to a schedule created by ScheduleRecurring.
+type RecurringBatch struct {
+	orch    *Orchestrator
+	spec    *cronSpec
+	factory func() []SubRequest
+	jitter  time.Duration
+	overlap OverlapPolicy
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+
+	mu      sync.Mutex
+	running bool
+	runs    int
+	skipped int
+}
+
+// ScheduleRecurring executes batches produced by factory on a five-field
+// cron schedule (minute, hour, day of month, month, day of week). Day
+// of month and day of week follow the standard cron rule: when both are
+// restricted, days matching either field fire. The factory is invoked
+// at each tick so the batch can reflect current state (e.g. the current
+// tenant list). Stop the schedule via the returned handle; shutdown
+// also stops it.
+//
+// Example:
+//
+//	// Hourly maintenance for every tenant.
+//	handle, err := orch.ScheduleRecurring("0 * * * *", func() []relayer.SubRequest {
+//		return maintenanceBatch(tenants())
+//	}, relayer.WithRecurringJitter(30*time.Second))
+func (o *Orchestrator) ScheduleRecurring(spec string, factory func() []SubRequest, opts ...RecurringOption) (*RecurringBatch, error) {
+	if factory == nil {
+		return nil, errors.New("batch factory cannot be nil")
+	}
+	parsed, err := parseCronSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &RecurringBatch{
+		orch:    o,
+		spec:    parsed,
+		factory: factory,
+		stopCh:  make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	go r.loop()
+	return r, nil
+}
+
+// Stop halts the schedule. Runs already in flight finish normally.
+// Stop is idempotent.
+func (r *RecurringBatch) Stop() {
+	r.stopOnce.Do(func() { close(r.stopCh) })
+}
+
+// Runs returns how many times the schedule has fired, and how many ticks
+// were skipped because a previous run was still executing.
+func (r *RecurringBatch) Runs() (fired, skipped int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.runs, r.skipped
+}
+
+func (r *RecurringBatch) loop() {
+	for {
+		now := r.orch.clock.Now()
+		next, ok := r.spec.next(now)
+		if !ok {
+			return
+		}
+		wait := next.Sub(now)
+		if r.jitter > 0 {
+			wait += time.Duration(rand.Int63n(int64(r.jitter)))
+		}
+
+		select {
+		case <-r.orch.clock.After(wait):
+		case <-r.stopCh:
+			return
+		case <-r.orch.shutdownCtx.Done():
+			return
+		}
+
+		r.fire()
+	}
+}
+
+func (r *RecurringBatch) fire() {
+	r.mu.Lock()
+	if r.running && r.overlap == OverlapSkip {
+		r.skipped++
+		r.mu.Unlock()
+		return
+	}
+	r.running = true
+	r.runs++
+	r.mu.Unlock()
+
+	go func() {
+		defer func() {
+			r.mu.Lock()
+			r.running = false
+			r.mu.Unlock()
+		}()
+		batch := r.factory()
+		if len(batch) == 0 {
+			return
+		}
+		r.orch.ExecuteBatch(context.Background(), batch)
+	}()
+}
+
+// cronSpec is a parsed five-field cron expression. Each field is a set
+// of permitted values. The restricted flags record whether day-of-month
+// and day-of-week were given explicitly (anything not starting with
+// "*"), which decides whether the two are ANDed or ORed; see dayMatches.
+type cronSpec struct {
+	minutes  map[int]bool // 0-59
+	hours    map[int]bool // 0-23
+	days     map[int]bool // 1-31
+	months   map[int]bool // 1-12
+	weekdays map[int]bool // 0-6, Sunday = 0
+
+	daysRestricted     bool
+	weekdaysRestricted bool
+}
+
+// parseCronSpec parses a five-field cron expression supporting "*",
+// "*/step", single values, ranges "a-b", and comma-separated lists.
+func parseCronSpec(spec string) (*cronSpec, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron spec %q: want 5 fields, got %d", spec, len(fields))
+	}
+
+	bounds := []struct {
+		name     string
+		min, max int
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 6},
+	}
+
+	sets := make([]map[int]bool, 5)
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i].min, bounds[i].max)
+		if err != nil {
+			return nil, fmt.Errorf("cron spec %q: %s field: %w", spec, bounds[i].name, err)
+		}
+		sets[i] = set
+	}
+
+	return &cronSpec{
+		minutes:  sets[0],
+		hours:    sets[1],
+		days:     sets[2],
+		months:   sets[3],
+		weekdays: sets[4],
+
+		daysRestricted:     !strings.HasPrefix(fields[2], "*"),
+		weekdaysRestricted: !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	set := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		expr, step := part, 1
+		if slash := strings.IndexByte(part, '/'); slash >= 0 {
+			s, err := strconv.Atoi(part[slash+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step %q", part)
+			}
+			expr, step = part[:slash], s
+		}
+
+		lo, hi := min, max
+		switch {
+		case expr == "*":
+		case strings.Contains(expr, "-"):
+			bounds := strings.SplitN(expr, "-", 2)
+			a, err1 := strconv.Atoi(bounds[0])
+			b, err2 := strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil || a > b {
+				return nil, fmt.Errorf("invalid range %q", expr)
+			}
+			lo, hi = a, b
+		default:
+			v, err := strconv.Atoi(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", expr)
+			}
+			lo, hi = v, v
+		}
+
+		if lo < min || hi > max {
+			return nil, fmt.Errorf("value out of range %d-%d in %q", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+// next returns the first time strictly after t that matches the spec.
+// Gives up after five years, which only happens for impossible specs
+// (e.g. Feb 30).
+func (s *cronSpec) next(t time.Time) (time.Time, bool) {
+	// Start from the next whole minute.
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// dayMatches applies the standard cron day rule: when both day-of-month
+// and day-of-week are restricted, a day matching either field fires —
+// "0 0 13 * 5" runs on every 13th and every Friday, not only
+// Friday-the-13th. Otherwise both fields must match (an unrestricted
+// field matches every day).
+func (s *cronSpec) dayMatches(t time.Time) bool {
+	dom := s.days[t.Day()]
+	dow := s.weekdays[int(t.Weekday())]
+	if s.daysRestricted && s.weekdaysRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
@@ -0,0 +1,226 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseCronSpec_Next(t *testing.T) {
+	base := time.Date(2024, time.March, 15, 10, 30, 45, 0, time.UTC) // a Friday
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"0 * * * *", time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.March, 15, 10, 45, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2024, time.March, 16, 2, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1", time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC)}, // next Monday
+		{"0,30 10-12 * * *", time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		spec, err := parseCronSpec(tt.spec)
+		if err != nil {
+			t.Errorf("parseCronSpec(%q) error = %v", tt.spec, err)
+			continue
+		}
+		got, ok := spec.next(base)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("next(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronSpec_DayFieldsAreORed(t *testing.T) {
+	// Standard cron: with both day fields restricted, "0 0 13 * 5" fires
+	// on every 13th and every Friday, not only Friday-the-13th.
+	spec, err := parseCronSpec("0 0 13 * 5")
+	if err != nil {
+		t.Fatalf("parseCronSpec error = %v", err)
+	}
+
+	// From mid-March the next Friday comes before the next 13th.
+	got, ok := spec.next(time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC))
+	want := time.Date(2024, time.March, 22, 0, 0, 0, 0, time.UTC)
+	if !ok || !got.Equal(want) {
+		t.Errorf("next = %v, want the following Friday %v", got, want)
+	}
+
+	// From Friday April 12 the 13th itself (a Saturday) fires.
+	got, ok = spec.next(time.Date(2024, time.April, 12, 12, 0, 0, 0, time.UTC))
+	want = time.Date(2024, time.April, 13, 0, 0, 0, 0, time.UTC)
+	if !ok || !got.Equal(want) {
+		t.Errorf("next = %v, want the 13th %v", got, want)
+	}
+
+	// With only day-of-month restricted the weekday stays unrestricted
+	// and the fields are ANDed as before.
+	spec, err = parseCronSpec("0 0 13 * *")
+	if err != nil {
+		t.Fatalf("parseCronSpec error = %v", err)
+	}
+	got, ok = spec.next(time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC))
+	want = time.Date(2024, time.April, 13, 0, 0, 0, 0, time.UTC)
+	if !ok || !got.Equal(want) {
+		t.Errorf("next = %v, want the next 13th %v", got, want)
+	}
+}
+
+func TestParseCronSpec_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"x * * * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+	} {
+		if _, err := parseCronSpec(spec); err == nil {
+			t.Errorf("parseCronSpec(%q) should fail", spec)
+		}
+	}
+}
+
+// cronTestClock is a minimal manual clock for driving the recurring
+// scheduler without waiting for real minutes to pass.
+type cronTestClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []struct {
+		at time.Time
+		ch chan time.Time
+	}
+}
+
+func (c *cronTestClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *cronTestClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	c.waiters = append(c.waiters, struct {
+		at time.Time
+		ch chan time.Time
+	}{c.now.Add(d), ch})
+	return ch
+}
+
+func (c *cronTestClock) advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	var due []chan time.Time
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			due = append(due, w.ch)
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	c.waiters = remaining
+	now := c.now
+	c.mu.Unlock()
+	for _, ch := range due {
+		ch <- now
+	}
+}
+
+// advanceUntil steps simulated time forward one second at a time until
+// cond holds, giving the scheduler goroutine room to re-arm between
+// steps.
+func (c *cronTestClock) advanceUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		c.advance(time.Second)
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("condition never held while advancing the clock")
+}
+
+func TestScheduleRecurring_FiresOnSchedule(t *testing.T) {
+	clock := &cronTestClock{now: time.Date(2024, time.March, 15, 10, 0, 30, 0, time.UTC)}
+	orch := New(WithClock(clock))
+
+	fired := make(chan struct{}, 10)
+	orch.RegisterRecipe("tick", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		fired <- struct{}{}
+		return nil, nil
+	})
+
+	handle, err := orch.ScheduleRecurring("* * * * *", func() []SubRequest {
+		return []SubRequest{{ID: "1", TenantID: "t", Recipe: "tick"}}
+	})
+	if err != nil {
+		t.Fatalf("ScheduleRecurring() error = %v", err)
+	}
+	defer handle.Stop()
+
+	clock.advanceUntil(t, func() bool { return len(fired) >= 1 })
+	clock.advanceUntil(t, func() bool { return len(fired) >= 2 })
+
+	if runs, _ := handle.Runs(); runs < 2 {
+		t.Errorf("Runs() = %d, want at least 2", runs)
+	}
+}
+
+func TestScheduleRecurring_OverlapSkip(t *testing.T) {
+	clock := &cronTestClock{now: time.Date(2024, time.March, 15, 10, 0, 30, 0, time.UTC)}
+	orch := New(WithClock(clock))
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 10)
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	})
+
+	handle, err := orch.ScheduleRecurring("* * * * *", func() []SubRequest {
+		return []SubRequest{{ID: "1", TenantID: "t", Recipe: "slow"}}
+	})
+	if err != nil {
+		t.Fatalf("ScheduleRecurring() error = %v", err)
+	}
+	defer handle.Stop()
+	defer close(release)
+
+	clock.advanceUntil(t, func() bool { return len(started) >= 1 })
+
+	// Subsequent ticks arrive while the first run is still blocked.
+	clock.advanceUntil(t, func() bool {
+		_, skipped := handle.Runs()
+		return skipped >= 1
+	})
+
+	runs, _ := handle.Runs()
+	if runs != 1 {
+		t.Errorf("fired runs = %d, want 1 while the first run blocks", runs)
+	}
+	if len(started) != 1 {
+		t.Errorf("recipe started %d times, want 1", len(started))
+	}
+}
+
+func TestScheduleRecurring_InvalidSpec(t *testing.T) {
+	orch := New()
+
+	if _, err := orch.ScheduleRecurring("not a cron", func() []SubRequest { return nil }); err == nil {
+		t.Error("ScheduleRecurring() should reject an invalid spec")
+	}
+	if _, err := orch.ScheduleRecurring("* * * * *", nil); err == nil {
+		t.Error("ScheduleRecurring() should reject a nil factory")
+	}
+}
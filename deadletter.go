@@ -0,0 +1,92 @@
+package relayer
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// DeadLetter is a permanently failed request: the original SubRequest
+// and the final Response after any configured retries were exhausted.
+type DeadLetter struct {
+	Request  SubRequest `json:"request"`
+	Response Response   `json:"response"`
+	FailedAt time.Time  `json:"failed_at"`
+}
+
+// DeadLetterSink receives permanently failed requests so operators can
+// inspect and replay them. Implementations must be safe for concurrent
+// use and should return quickly; publishing happens on the request path.
+type DeadLetterSink interface {
+	Publish(letter DeadLetter) error
+}
+
+// WithDeadLetterSink publishes every permanently failed request (final
+// status >= 500, after retries were exhausted) to the sink. Publish
+// errors are ignored: dead-lettering is best-effort and must never fail
+// the original request a second time.
+//
+// Example:
+//
+//	sink := relayer.NewMemoryDeadLetterSink()
+//	orch := relayer.New(relayer.WithDeadLetterSink(sink))
+func WithDeadLetterSink(sink DeadLetterSink) Option {
+	return func(o *Orchestrator) {
+		o.deadLetters = sink
+	}
+}
+
+// MemoryDeadLetterSink collects dead letters in memory, for tests and
+// small deployments.
+type MemoryDeadLetterSink struct {
+	mu      sync.Mutex
+	letters []DeadLetter
+}
+
+// NewMemoryDeadLetterSink creates an empty in-memory sink.
+func NewMemoryDeadLetterSink() *MemoryDeadLetterSink {
+	return &MemoryDeadLetterSink{}
+}
+
+// Publish appends the letter to the in-memory list.
+func (s *MemoryDeadLetterSink) Publish(letter DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+// Letters returns a copy of the collected dead letters.
+func (s *MemoryDeadLetterSink) Letters() []DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]DeadLetter(nil), s.letters...)
+}
+
+// NDJSONDeadLetterSink writes one JSON object per line to a writer
+// (typically an append-mode file), producing a replayable NDJSON log of
+// failed requests.
+type NDJSONDeadLetterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewNDJSONDeadLetterSink wraps a writer as a dead-letter sink. The
+// caller owns the writer's lifecycle (e.g., closing the file).
+func NewNDJSONDeadLetterSink(w io.Writer) *NDJSONDeadLetterSink {
+	return &NDJSONDeadLetterSink{w: w}
+}
+
+// Publish writes the letter as a single NDJSON line.
+func (s *NDJSONDeadLetterSink) Publish(letter DeadLetter) error {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
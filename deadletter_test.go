@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeadLetter_PublishedAfterRetriesExhausted(t *testing.T) {
+	sink := NewMemoryDeadLetterSink()
+	orch := New(WithDeadLetterSink(sink))
+
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("permanent failure")
+	}, &RecipeOption{
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "broken", Payload: "p"},
+	})
+
+	letters := sink.Letters()
+	if len(letters) != 1 {
+		t.Fatalf("Got %d dead letters, want 1", len(letters))
+	}
+
+	if letters[0].Request.ID != "1" {
+		t.Errorf("Letter request ID = %s, want 1", letters[0].Request.ID)
+	}
+	if letters[0].Response.Status != 500 {
+		t.Errorf("Letter response status = %d, want 500", letters[0].Response.Status)
+	}
+	if letters[0].FailedAt.IsZero() {
+		t.Error("Letter FailedAt not set")
+	}
+}
+
+func TestDeadLetter_SuccessesAndClientErrorsNotPublished(t *testing.T) {
+	sink := NewMemoryDeadLetterSink()
+	orch := New(WithDeadLetterSink(sink))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},    // 200
+		{ID: "2", TenantID: "tenant-a", Recipe: "missing"}, // 404
+		{ID: "", TenantID: "", Recipe: ""},                 // 400
+	})
+
+	if letters := sink.Letters(); len(letters) != 0 {
+		t.Errorf("Got %d dead letters, want 0: %+v", len(letters), letters)
+	}
+}
+
+func TestNDJSONDeadLetterSink_WritesReplayableLines(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewNDJSONDeadLetterSink(&buf)
+	orch := New(WithDeadLetterSink(sink))
+
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("nope")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "broken"},
+		{ID: "2", TenantID: "tenant-b", Recipe: "broken"},
+	})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Got %d NDJSON lines, want 2", len(lines))
+	}
+
+	for _, line := range lines {
+		var letter DeadLetter
+		if err := json.Unmarshal([]byte(line), &letter); err != nil {
+			t.Errorf("Line not valid JSON: %v", err)
+		}
+		if letter.Request.Recipe != "broken" {
+			t.Errorf("Letter recipe = %s, want broken", letter.Request.Recipe)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package relayer
+
+import (
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+// debugCounters are the always-on counters behind DebugSnapshot and
+// PublishExpvar: cheap enough to maintain unconditionally, unlike the
+// opt-in StatsCollector.
+type debugCounters struct {
+	total  atomic.Int64
+	mu     sync.Mutex
+	errors map[string]int64
+}
+
+// record counts one completed request, tracking failures per recipe.
+func (c *debugCounters) record(recipe string, failed bool) {
+	c.total.Add(1)
+	if !failed {
+		return
+	}
+	c.mu.Lock()
+	if c.errors == nil {
+		c.errors = make(map[string]int64)
+	}
+	c.errors[recipe]++
+	c.mu.Unlock()
+}
+
+// errorCounts returns a copy of the per-recipe error counts.
+func (c *debugCounters) errorCounts() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[string]int64, len(c.errors))
+	for recipe, count := range c.errors {
+		out[recipe] = count
+	}
+	return out
+}
+
+// DebugSnapshot is a point-in-time view of the orchestrator's health
+// counters, built for quick production triage (expvar, debug
+// endpoints) rather than full metrics pipelines.
+type DebugSnapshot struct {
+	InFlight       int              `json:"in_flight"`        // Requests currently executing
+	TotalRequests  int64            `json:"total_requests"`   // Requests completed since start
+	ErrorsByRecipe map[string]int64 `json:"errors_by_recipe"` // Failed requests per recipe
+	QueueDepth     int              `json:"queue_depth"`      // Requests waiting for a concurrency slot
+	LeakedHandlers int64            `json:"leaked_handlers"`  // Detached handlers still running
+	Recipes        []RecipeInfo     `json:"recipes"`          // Registered recipe metadata
+}
+
+// DebugSnapshot captures the current counters and recipe registry.
+func (o *Orchestrator) DebugSnapshot() DebugSnapshot {
+	snapshot := DebugSnapshot{
+		InFlight:       len(o.active.snapshot()),
+		TotalRequests:  o.debug.total.Load(),
+		ErrorsByRecipe: o.debug.errorCounts(),
+		LeakedHandlers: o.leaked.Load(),
+		Recipes:        o.ListRecipes(),
+	}
+	if o.semaphore != nil {
+		snapshot.QueueDepth = o.semaphore.depth()
+	}
+	return snapshot
+}
+
+// PublishExpvar publishes the orchestrator's counters as expvar
+// variables under the given prefix (e.g. "relayer" yields
+// "relayer.in_flight", "relayer.total_requests",
+// "relayer.errors_by_recipe", "relayer.queue_depth", and
+// "relayer.leaked_handlers"). Call once per orchestrator per process:
+// expvar panics on duplicate names. Panics if prefix is empty.
+//
+// Example:
+//
+//	orch.PublishExpvar("relayer")
+//	// counters now appear on the standard /debug/vars endpoint
+func (o *Orchestrator) PublishExpvar(prefix string) {
+	if prefix == "" {
+		panic("expvar prefix cannot be empty")
+	}
+
+	expvar.Publish(prefix+".in_flight", expvar.Func(func() interface{} {
+		return len(o.active.snapshot())
+	}))
+	expvar.Publish(prefix+".total_requests", expvar.Func(func() interface{} {
+		return o.debug.total.Load()
+	}))
+	expvar.Publish(prefix+".errors_by_recipe", expvar.Func(func() interface{} {
+		return o.debug.errorCounts()
+	}))
+	expvar.Publish(prefix+".queue_depth", expvar.Func(func() interface{} {
+		if o.semaphore == nil {
+			return 0
+		}
+		return o.semaphore.depth()
+	}))
+	expvar.Publish(prefix+".leaked_handlers", expvar.Func(func() interface{} {
+		return o.leaked.Load()
+	}))
+}
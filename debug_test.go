@@ -0,0 +1,70 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"testing"
+)
+
+func TestDebugSnapshot_Counters(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+		{ID: "2", TenantID: "t", Recipe: "bad"},
+		{ID: "3", TenantID: "t", Recipe: "bad"},
+	})
+
+	snap := orch.DebugSnapshot()
+	if snap.TotalRequests != 3 {
+		t.Errorf("TotalRequests = %d, want 3", snap.TotalRequests)
+	}
+	if snap.ErrorsByRecipe["bad"] != 2 || snap.ErrorsByRecipe["ok"] != 0 {
+		t.Errorf("ErrorsByRecipe = %v, want bad:2 only", snap.ErrorsByRecipe)
+	}
+	if snap.InFlight != 0 {
+		t.Errorf("InFlight = %d, want 0 after the batch", snap.InFlight)
+	}
+	if len(snap.Recipes) != 2 {
+		t.Errorf("Recipes = %v, want both registered recipes", snap.Recipes)
+	}
+}
+
+func TestPublishExpvar(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	orch.PublishExpvar("relayer_test")
+
+	v := expvar.Get("relayer_test.total_requests")
+	if v == nil {
+		t.Fatal("relayer_test.total_requests not published")
+	}
+	if got := v.String(); got != "1" {
+		t.Errorf("total_requests = %s, want 1", got)
+	}
+	if expvar.Get("relayer_test.queue_depth") == nil {
+		t.Error("relayer_test.queue_depth not published")
+	}
+}
+
+func TestPublishExpvar_EmptyPrefixPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for empty prefix")
+		}
+	}()
+	New().PublishExpvar("")
+}
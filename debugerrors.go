@@ -0,0 +1,59 @@
+package relayer
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WithDebugErrors exposes internal failure detail in Error.Details:
+// panic values and their stack traces, the concrete error type, and the
+// full wrapped error chain. Intended for local development and staging
+// — the default behavior stays sanitized, so nothing internal reaches
+// clients in production.
+//
+// Example:
+//
+//	opts := []relayer.Option{relayer.WithTimeout(time.Second)}
+//	if cfg.Env != "production" {
+//		opts = append(opts, relayer.WithDebugErrors())
+//	}
+//	orch := relayer.New(opts...)
+func WithDebugErrors() Option {
+	return func(o *Orchestrator) {
+		o.debugErrors = true
+	}
+}
+
+// panicDebugDetails builds the Error.Details for a panic response.
+// Returns nil unless WithDebugErrors is enabled.
+func (o *Orchestrator) panicDebugDetails(pe *panicError) map[string]interface{} {
+	if !o.debugErrors {
+		return nil
+	}
+	return map[string]interface{}{
+		"panic_value": fmt.Sprintf("%v", pe.value),
+		"stack":       string(pe.stack),
+	}
+}
+
+// errorDebugDetails builds the Error.Details for a recipe error
+// response. Returns nil unless WithDebugErrors is enabled.
+func (o *Orchestrator) errorDebugDetails(err error) map[string]interface{} {
+	if !o.debugErrors {
+		return nil
+	}
+	return map[string]interface{}{
+		"error_type":  fmt.Sprintf("%T", err),
+		"error_chain": errorChain(err),
+	}
+}
+
+// errorChain returns each message in err's Unwrap chain, outermost
+// first, so wrapped causes are visible individually.
+func errorChain(err error) []interface{} {
+	var chain []interface{}
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		chain = append(chain, e.Error())
+	}
+	return chain
+}
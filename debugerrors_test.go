@@ -0,0 +1,80 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestWithDebugErrors_PanicDetails(t *testing.T) {
+	orch := New(WithDebugErrors())
+	orch.RegisterRecipe("boom", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "boom"},
+	})
+
+	details := results[0].Error.Details
+	if details == nil {
+		t.Fatal("Details = nil, want panic details")
+	}
+	if details["panic_value"] != "kaboom" {
+		t.Errorf("panic_value = %v, want kaboom", details["panic_value"])
+	}
+	stack, _ := details["stack"].(string)
+	if !strings.Contains(stack, "panic") {
+		t.Errorf("stack does not look like a stack trace:\n%s", stack)
+	}
+}
+
+func TestWithDebugErrors_ErrorChain(t *testing.T) {
+	orch := New(WithDebugErrors())
+	base := errors.New("connection refused")
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("calling upstream: %w", base)
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fail"},
+	})
+
+	details := results[0].Error.Details
+	if details == nil {
+		t.Fatal("Details = nil, want error chain")
+	}
+	chain, _ := details["error_chain"].([]interface{})
+	if len(chain) != 2 {
+		t.Fatalf("error_chain = %v, want 2 entries", chain)
+	}
+	if chain[0] != "calling upstream: connection refused" || chain[1] != "connection refused" {
+		t.Errorf("error_chain = %v, want outermost first", chain)
+	}
+	if details["error_type"] != "*fmt.wrapError" {
+		t.Errorf("error_type = %v, want *fmt.wrapError", details["error_type"])
+	}
+}
+
+func TestWithDebugErrors_DefaultStaysSanitized(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("boom", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "boom"},
+		{ID: "2", TenantID: "t", Recipe: "fail"},
+	})
+
+	for _, resp := range results {
+		if resp.Error.Details != nil {
+			t.Errorf("request %s: Details = %v, want nil without WithDebugErrors", resp.ID, resp.Error.Details)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package relayer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// DedupKeyFunc derives the deduplication key for a request. Requests in
+// the same batch that share a key execute once, with the result fanned
+// out to every duplicate.
+type DedupKeyFunc func(SubRequest) string
+
+// DefaultDedupKey keys requests by tenant, recipe, and a hash of the
+// JSON-encoded payload. Payloads that fail to encode fall back to their
+// Go string representation, which is stable for the common
+// map/slice/scalar payloads produced by JSON decoding.
+func DefaultDedupKey(req SubRequest) string {
+	var payload string
+	if data, err := json.Marshal(req.Payload); err == nil {
+		sum := sha256.Sum256(data)
+		payload = hex.EncodeToString(sum[:])
+	} else {
+		payload = fmt.Sprintf("%v", req.Payload)
+	}
+	return req.TenantID + "|" + req.Recipe + "|" + payload
+}
+
+// WithDeduplication enables in-batch request coalescing: sub-requests
+// with the same dedup key execute the handler once and share the result.
+// Pass nil to use DefaultDedupKey (tenant + recipe + payload hash).
+//
+// Each duplicate still receives its own Response with its own request ID,
+// so callers cannot observe whether coalescing occurred except through
+// reduced handler invocations.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithDeduplication(nil))
+func WithDeduplication(keyFunc DedupKeyFunc) Option {
+	return func(o *Orchestrator) {
+		if keyFunc == nil {
+			keyFunc = DefaultDedupKey
+		}
+		o.dedupKey = keyFunc
+	}
+}
+
+// executeBatchDeduped executes one representative per dedup group and
+// fans results out to duplicates. Invalid requests are excluded from
+// grouping so they keep their individual validation errors.
+func (o *Orchestrator) executeBatchDeduped(ctx context.Context, batch []SubRequest) []Response {
+	results := make([]Response, len(batch))
+
+	// representative[key] is the index of the first request with that key.
+	representative := make(map[string]int, len(batch))
+	duplicates := make(map[int][]int) // representative index -> duplicate indices
+
+	var wg sync.WaitGroup
+	for i, req := range batch {
+		// Invalid requests bypass dedup so each gets its own 400.
+		if req.ID == "" || req.TenantID == "" || req.Recipe == "" {
+			wg.Add(1)
+			req := req
+			result := &results[i]
+			o.scheduler.Schedule(func() {
+				o.executeRequest(ctx, &wg, req, result)
+			})
+			continue
+		}
+
+		key := o.dedupKey(req)
+		if repIdx, seen := representative[key]; seen {
+			duplicates[repIdx] = append(duplicates[repIdx], i)
+			continue
+		}
+		representative[key] = i
+
+		wg.Add(1)
+		req := req
+		result := &results[i]
+		o.scheduler.Schedule(func() {
+			o.executeRequest(ctx, &wg, req, result)
+		})
+	}
+
+	wg.Wait()
+
+	// Fan representative results out to duplicates, preserving each
+	// duplicate's own identity fields.
+	for repIdx, dupIdxs := range duplicates {
+		for _, i := range dupIdxs {
+			resp := results[repIdx]
+			resp.ID = batch[i].ID
+			resp.TenantID = batch[i].TenantID
+			results[i] = resp
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,110 @@
+package relayer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDeduplication_CoalescesIdenticalRequests(t *testing.T) {
+	orch := New(WithDeduplication(nil))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "same"},
+		{ID: "2", TenantID: "tenant-a", Recipe: "echo", Payload: "same"},
+		{ID: "3", TenantID: "tenant-a", Recipe: "echo", Payload: "same"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1", n)
+	}
+
+	for i, resp := range results {
+		if resp.ID != batch[i].ID {
+			t.Errorf("Result %d ID = %s, want %s", i, resp.ID, batch[i].ID)
+		}
+		if resp.Status != 200 || resp.Data != "same" {
+			t.Errorf("Result %d = %+v, want 200/'same'", i, resp)
+		}
+	}
+}
+
+func TestDeduplication_DifferentTenantsNotCoalesced(t *testing.T) {
+	orch := New(WithDeduplication(nil))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "same"},
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo", Payload: "same"},
+	}
+
+	orch.ExecuteBatch(context.Background(), batch)
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Handler called %d times, want 2 (tenants must not share results)", n)
+	}
+}
+
+func TestDeduplication_CustomKeyFunc(t *testing.T) {
+	// Key on recipe only: every request to the same recipe coalesces.
+	orch := New(WithDeduplication(func(req SubRequest) string {
+		return req.Recipe
+	}))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return "shared", nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "x"},
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo", Payload: "y"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1", n)
+	}
+
+	// Duplicates keep their own tenant IDs.
+	if results[1].TenantID != "tenant-b" {
+		t.Errorf("Result 1 tenant = %s, want tenant-b", results[1].TenantID)
+	}
+}
+
+func TestDeduplication_InvalidRequestsKeepOwnErrors(t *testing.T) {
+	orch := New(WithDeduplication(nil))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "ok"},
+		{ID: "", TenantID: "", Recipe: ""},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if results[0].Status != 200 {
+		t.Errorf("Result 0 status = %d, want 200", results[0].Status)
+	}
+	if results[1].Status != 400 {
+		t.Errorf("Result 1 status = %d, want 400", results[1].Status)
+	}
+}
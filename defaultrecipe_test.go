@@ -0,0 +1,56 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegisterDefaultRecipe_CatchesUnknownRecipes(t *testing.T) {
+	orch := New()
+
+	orch.RegisterDefaultRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		name, _ := RecipeName(ctx)
+		return "forwarded:" + name, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "legacy-lookup"},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200 via the catch-all", results[0].Status)
+	}
+	if results[0].Data != "forwarded:legacy-lookup" {
+		t.Errorf("Data = %v, want the requested recipe name forwarded", results[0].Data)
+	}
+}
+
+func TestRegisterDefaultRecipe_RegisteredRecipesStillWin(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("known", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "direct", nil
+	})
+	orch.RegisterDefaultRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "fallback", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "known"},
+	})
+
+	if results[0].Data != "direct" {
+		t.Errorf("Data = %v, want the registered handler's result", results[0].Data)
+	}
+}
+
+func TestUnknownRecipeWithoutDefaultStill404s(t *testing.T) {
+	orch := New()
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "missing"},
+	})
+
+	if results[0].Status != 404 {
+		t.Errorf("Status = %d, want 404 with no catch-all registered", results[0].Status)
+	}
+}
@@ -0,0 +1,262 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches ${id.path} references inside payloads.
+var placeholderPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// ExecuteBatchDAG executes a batch whose requests may declare
+// dependencies via SubRequest.DependsOn. Requests run in dependency
+// order with independent requests still executing concurrently, and a
+// dependent request's payload may reference upstream results through
+// placeholders resolved before it runs:
+//
+//	{"user_id": "${req-1.data.id}"}
+//
+// The path after the request ID selects "data" (walking into nested
+// maps) or "status"; a bare ${req-1} injects the upstream Data. A
+// placeholder that is the entire string injects the raw value,
+// otherwise the value is interpolated into the string.
+//
+// Requests whose dependency failed receive a 424 DEPENDENCY_FAILED
+// without executing. Unknown dependencies and dependency cycles produce
+// a 400 INVALID_REQUEST. Responses are returned in input order.
+//
+// Example:
+//
+//	results := orch.ExecuteBatchDAG(ctx, []relayer.SubRequest{
+//		{ID: "req-1", TenantID: "t", Recipe: "create-user", Payload: user},
+//		{ID: "req-2", TenantID: "t", Recipe: "send-welcome", DependsOn: []string{"req-1"},
+//			Payload: map[string]interface{}{"user_id": "${req-1.data.id}"}},
+//	})
+func (o *Orchestrator) ExecuteBatchDAG(ctx context.Context, batch []SubRequest) []Response {
+	ctx = ensureBatchID(ctx)
+
+	index := make(map[string]int, len(batch))
+	for i, req := range batch {
+		index[req.ID] = i
+	}
+
+	results := make([]Response, len(batch))
+	completed := make(map[string]Response, len(batch))
+	pending := make(map[int]bool, len(batch))
+	for i := range batch {
+		pending[i] = true
+	}
+
+	// Fail requests naming dependencies outside the batch up front; they
+	// could never become ready.
+	for i, req := range batch {
+		for _, dep := range req.DependsOn {
+			if _, ok := index[dep]; !ok {
+				results[i] = invalidDAGResponse(req, fmt.Sprintf("unknown dependency '%s'", dep))
+				completed[req.ID] = results[i]
+				delete(pending, i)
+				break
+			}
+		}
+	}
+
+	for len(pending) > 0 {
+		// Collect requests whose dependencies have all completed.
+		var wave []int
+		for i := range pending {
+			ready := true
+			for _, dep := range batch[i].DependsOn {
+				if _, done := completed[dep]; !done {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				wave = append(wave, i)
+			}
+		}
+
+		// No request is ready but some remain: the rest form a cycle.
+		if len(wave) == 0 {
+			for i := range pending {
+				results[i] = invalidDAGResponse(batch[i], "dependency cycle")
+			}
+			return results
+		}
+
+		// Partition the wave into executable requests and ones whose
+		// upstream failed.
+		var execIdx []int
+		var execReqs []SubRequest
+		for _, i := range wave {
+			req := batch[i]
+			delete(pending, i)
+
+			if failedDep := firstFailedDependency(req, completed); failedDep != "" {
+				results[i] = Response{
+					ID:       req.ID,
+					Status:   424,
+					TenantID: req.TenantID,
+					Error: &Error{
+						Code:    ErrCodeDependencyFailed,
+						Message: fmt.Sprintf("dependency '%s' did not succeed", failedDep),
+						Details: map[string]interface{}{"dependency": failedDep},
+					},
+				}
+				completed[req.ID] = results[i]
+				continue
+			}
+
+			resolved, err := resolvePlaceholders(req.Payload, completed)
+			if err != nil {
+				results[i] = Response{
+					ID:       req.ID,
+					Status:   400,
+					TenantID: req.TenantID,
+					Error: &Error{
+						Code:    ErrCodeInvalidPayload,
+						Message: err.Error(),
+					},
+				}
+				completed[req.ID] = results[i]
+				continue
+			}
+			req.Payload = resolved
+			execIdx = append(execIdx, i)
+			execReqs = append(execReqs, req)
+		}
+
+		if len(execReqs) > 0 {
+			waveResults := o.ExecuteBatch(ctx, execReqs)
+			for j, i := range execIdx {
+				results[i] = waveResults[j]
+				completed[batch[i].ID] = waveResults[j]
+			}
+		}
+	}
+
+	return results
+}
+
+// invalidDAGResponse builds the 400 returned for structurally invalid
+// dependency declarations.
+func invalidDAGResponse(req SubRequest, msg string) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   400,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeInvalidRequest,
+			Message: msg,
+		},
+	}
+}
+
+// firstFailedDependency returns the ID of the first declared dependency
+// that completed unsuccessfully, or "" if all succeeded.
+func firstFailedDependency(req SubRequest, completed map[string]Response) string {
+	for _, dep := range req.DependsOn {
+		if resp := completed[dep]; resp.Status < 200 || resp.Status >= 300 {
+			return dep
+		}
+	}
+	return ""
+}
+
+// resolvePlaceholders walks a payload and substitutes ${id.path}
+// references with values from completed responses.
+func resolvePlaceholders(payload interface{}, completed map[string]Response) (interface{}, error) {
+	switch v := payload.(type) {
+	case string:
+		return resolveString(v, completed)
+
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			resolved, err := resolvePlaceholders(value, completed)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			resolved, err := resolvePlaceholders(value, completed)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+
+	default:
+		return payload, nil
+	}
+}
+
+// resolveString substitutes placeholders in one string. A string that
+// is exactly one placeholder injects the referenced value unchanged;
+// otherwise each reference is interpolated as text.
+func resolveString(s string, completed map[string]Response) (interface{}, error) {
+	match := placeholderPattern.FindStringSubmatch(s)
+	if match == nil {
+		return s, nil
+	}
+
+	if match[0] == s {
+		return lookupPlaceholder(match[1], completed)
+	}
+
+	var resolveErr error
+	out := placeholderPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		inner := ref[2 : len(ref)-1]
+		value, err := lookupPlaceholder(inner, completed)
+		if err != nil && resolveErr == nil {
+			resolveErr = err
+		}
+		return fmt.Sprintf("%v", value)
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return out, nil
+}
+
+// lookupPlaceholder resolves a reference of the form
+// "id", "id.status", or "id.data.nested.field".
+func lookupPlaceholder(ref string, completed map[string]Response) (interface{}, error) {
+	parts := strings.Split(ref, ".")
+	resp, ok := completed[parts[0]]
+	if !ok {
+		return nil, fmt.Errorf("placeholder '${%s}' references request '%s', which is not a declared dependency", ref, parts[0])
+	}
+
+	if len(parts) == 1 {
+		return resp.Data, nil
+	}
+
+	switch parts[1] {
+	case "status":
+		return resp.Status, nil
+	case "data":
+		value := resp.Data
+		for _, part := range parts[2:] {
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("placeholder '${%s}': cannot select '%s' from %T", ref, part, value)
+			}
+			value, ok = obj[part]
+			if !ok {
+				return nil, fmt.Errorf("placeholder '${%s}': no field '%s' in upstream result", ref, part)
+			}
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("placeholder '${%s}': path must start with 'data' or 'status'", ref)
+	}
+}
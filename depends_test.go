@@ -0,0 +1,116 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestExecuteBatchDAG_InjectsUpstreamResults(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("create-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return map[string]interface{}{"id": "u-42"}, nil
+	})
+	orch.RegisterRecipe("send-welcome", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatchDAG(context.Background(), []SubRequest{
+		{ID: "req-1", TenantID: "t", Recipe: "create-user"},
+		{ID: "req-2", TenantID: "t", Recipe: "send-welcome", DependsOn: []string{"req-1"},
+			Payload: map[string]interface{}{
+				"user_id": "${req-1.data.id}",
+				"note":    "created with status ${req-1.status}",
+			}},
+	})
+
+	if results[1].Status != 200 {
+		t.Fatalf("req-2 = %+v, want 200", results[1])
+	}
+	payload := results[1].Data.(map[string]interface{})
+	if payload["user_id"] != "u-42" {
+		t.Errorf("user_id = %v, want the raw upstream value u-42", payload["user_id"])
+	}
+	if payload["note"] != "created with status 200" {
+		t.Errorf("note = %v, want interpolated status", payload["note"])
+	}
+}
+
+func TestExecuteBatchDAG_FailedDependencyIs424(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("nope")
+	})
+	orch.RegisterRecipe("dependent", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		t.Error("dependent ran despite a failed upstream")
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatchDAG(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "fail"},
+		{ID: "b", TenantID: "t", Recipe: "dependent", DependsOn: []string{"a"}},
+	})
+
+	if results[1].Status != 424 || results[1].Error.Code != ErrCodeDependencyFailed {
+		t.Errorf("got (%d, %v), want a 424 DEPENDENCY_FAILED", results[1].Status, results[1].Error)
+	}
+	if results[1].Error.Details["dependency"] != "a" {
+		t.Errorf("Details = %v, want the failed dependency named", results[1].Error.Details)
+	}
+}
+
+func TestExecuteBatchDAG_CycleAndUnknownDependency(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("noop", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatchDAG(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "noop", DependsOn: []string{"b"}},
+		{ID: "b", TenantID: "t", Recipe: "noop", DependsOn: []string{"a"}},
+		{ID: "c", TenantID: "t", Recipe: "noop", DependsOn: []string{"ghost"}},
+	})
+
+	for i, want := range []string{"dependency cycle", "dependency cycle", "unknown dependency 'ghost'"} {
+		if results[i].Status != 400 || results[i].Error.Message != want {
+			t.Errorf("results[%d] = (%d, %v), want (400, %s)", i, results[i].Status, results[i].Error, want)
+		}
+	}
+}
+
+func TestExecuteBatchDAG_IndependentRequestsShareOneBatch(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("noop", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatchDAG(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "noop"},
+		{ID: "b", TenantID: "t", Recipe: "noop", DependsOn: []string{"a"}},
+	})
+
+	if results[0].BatchID == "" || results[0].BatchID != results[1].BatchID {
+		t.Errorf("BatchIDs = %q, %q: all waves must share one batch ID",
+			results[0].BatchID, results[1].BatchID)
+	}
+}
+
+func TestExecuteBatchDAG_UnresolvablePlaceholderIs400(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("first", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return map[string]interface{}{"id": "x"}, nil
+	})
+	orch.RegisterRecipe("second", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatchDAG(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "first"},
+		{ID: "b", TenantID: "t", Recipe: "second", DependsOn: []string{"a"},
+			Payload: map[string]interface{}{"v": "${a.data.absent}"}},
+	})
+
+	if results[1].Status != 400 || results[1].Error.Code != ErrCodeInvalidPayload {
+		t.Errorf("got (%d, %v), want a 400 INVALID_PAYLOAD", results[1].Status, results[1].Error)
+	}
+}
@@ -0,0 +1,63 @@
+package relayer
+
+import "context"
+
+// DistributedLimiter is a concurrency semaphore shared by multiple
+// orchestrator replicas, so a fleet pointed at one downstream can bound
+// its combined in-flight requests the way WithMaxConcurrency bounds a
+// single process. Implementations typically sit on a shared store
+// (Redis, etcd) holding a counted lock with an expiry so crashed
+// replicas don't leak slots.
+//
+// Acquire blocks until a slot is available or ctx is done, returning a
+// release function for the slot. An error other than the context's
+// admits the request (fail open): a limiter backend outage should
+// degrade the global bound to the local one, not halt execution.
+type DistributedLimiter interface {
+	Acquire(ctx context.Context) (release func(), err error)
+}
+
+// WithDistributedLimiter adds a fleet-wide concurrency bound, acquired
+// after the local WithMaxConcurrency slot so a replica never holds
+// shared slots for requests still queued locally. Panics if limiter is
+// nil.
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithMaxConcurrency(50),          // per replica
+//		relayer.WithDistributedLimiter(redisSem), // across the fleet
+//	)
+func WithDistributedLimiter(limiter DistributedLimiter) Option {
+	return func(o *Orchestrator) {
+		if limiter == nil {
+			panic("distributed limiter cannot be nil")
+		}
+		o.distributed = limiter
+	}
+}
+
+// localDistributedLimiter adapts a buffered channel to the
+// DistributedLimiter interface. Useful as an in-process stand-in in
+// tests and single-replica deployments.
+type localDistributedLimiter chan struct{}
+
+// NewLocalDistributedLimiter returns a process-local DistributedLimiter
+// with the given capacity, for tests and single-replica deployments of
+// code written against the interface. Panics if capacity < 1.
+func NewLocalDistributedLimiter(capacity int) DistributedLimiter {
+	if capacity < 1 {
+		panic("distributed limiter capacity must be at least 1")
+	}
+	return make(localDistributedLimiter, capacity)
+}
+
+// Acquire implements DistributedLimiter.
+func (l localDistributedLimiter) Acquire(ctx context.Context) (func(), error) {
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,102 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDistributedLimiter_BoundsAcrossOrchestrators(t *testing.T) {
+	limiter := NewLocalDistributedLimiter(1)
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	slow := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return "ok", nil
+	}
+
+	// Two replicas sharing one limiter, as a fleet would share one store
+	first := New(WithDistributedLimiter(limiter))
+	second := New(WithDistributedLimiter(limiter))
+	first.RegisterRecipe("slow", slow)
+	second.RegisterRecipe("slow", slow)
+
+	var wg sync.WaitGroup
+	for _, orch := range []*Orchestrator{first, second} {
+		wg.Add(1)
+		orch := orch
+		go func() {
+			defer wg.Done()
+			orch.ExecuteBatch(context.Background(), []SubRequest{
+				{ID: "1", TenantID: "t", Recipe: "slow"},
+				{ID: "2", TenantID: "t", Recipe: "slow"},
+			})
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if peak > 1 {
+		t.Errorf("peak concurrency = %d, want 1 across both orchestrators", peak)
+	}
+}
+
+func TestDistributedLimiter_CancelledWaitReturns504(t *testing.T) {
+	limiter := NewLocalDistributedLimiter(1)
+
+	// Hold the only slot so the request has to wait
+	release, err := limiter.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	defer release()
+
+	orch := New(WithDistributedLimiter(limiter))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	results := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 504 {
+		t.Errorf("Status = %d, want 504", results[0].Status)
+	}
+}
+
+type brokenLimiter struct{}
+
+func (brokenLimiter) Acquire(ctx context.Context) (func(), error) {
+	return nil, errors.New("backend unavailable")
+}
+
+func TestDistributedLimiter_BackendErrorFailsOpen(t *testing.T) {
+	orch := New(WithDistributedLimiter(brokenLimiter{}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 when the limiter backend errors", results[0].Status)
+	}
+}
@@ -0,0 +1,71 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithRejectDuplicateIDs fails sub-requests that repeat an ID already
+// used earlier in the same batch with a 400 INVALID_REQUEST response.
+// The first occurrence of each ID executes normally, so callers can
+// always key the result set by request ID. Empty IDs are left to the
+// standard field validation. Disabled by default: duplicate IDs then
+// all execute, producing result sets that are ambiguous to key by ID.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithRejectDuplicateIDs())
+func WithRejectDuplicateIDs() Option {
+	return func(o *Orchestrator) {
+		o.rejectDuplicateIDs = true
+	}
+}
+
+// executeBatchRejectDups splits the batch into first occurrences, which
+// execute through the normal path, and duplicates, which fail
+// immediately. Results are scattered back into input order.
+func (o *Orchestrator) executeBatchRejectDups(ctx context.Context, batch []SubRequest) []Response {
+	results := make([]Response, len(batch))
+	seen := make(map[string]bool, len(batch))
+	unique := make([]SubRequest, 0, len(batch))
+	uniqueIdx := make([]int, 0, len(batch))
+
+	for i, req := range batch {
+		if req.ID != "" && seen[req.ID] {
+			results[i] = duplicateIDResponse(req)
+			continue
+		}
+		seen[req.ID] = true
+		unique = append(unique, req)
+		uniqueIdx = append(uniqueIdx, i)
+	}
+
+	uniqueResults := make([]Response, len(unique))
+	switch {
+	case o.dedupKey != nil && len(unique) > 1:
+		uniqueResults = o.executeBatchDeduped(ctx, unique)
+	case len(unique) == 1:
+		o.processRequest(ctx, unique[0], &uniqueResults[0])
+	default:
+		o.dispatch(ctx, unique, uniqueResults)
+	}
+
+	for i, resp := range uniqueResults {
+		results[uniqueIdx[i]] = resp
+	}
+	return results
+}
+
+// duplicateIDResponse is the 400 returned for the second and later
+// occurrences of a request ID within one batch.
+func duplicateIDResponse(req SubRequest) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   400,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeInvalidRequest,
+			Message: fmt.Sprintf("duplicate request ID %q in batch", req.ID),
+		},
+	}
+}
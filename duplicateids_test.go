@@ -0,0 +1,110 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithRejectDuplicateIDs_FailsDuplicates(t *testing.T) {
+	var executions atomic.Int64
+	orch := New(WithRejectDuplicateIDs())
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executions.Add(1)
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "first"},
+		{ID: "b", TenantID: "t", Recipe: "echo", Payload: "other"},
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "second"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("first occurrence: Status = %d, want 200", results[0].Status)
+	}
+	if results[1].Status != 200 {
+		t.Errorf("unique ID: Status = %d, want 200", results[1].Status)
+	}
+	if results[2].Status != 400 {
+		t.Errorf("duplicate: Status = %d, want 400", results[2].Status)
+	}
+	if results[2].Error == nil || results[2].Error.Code != ErrCodeInvalidRequest {
+		t.Fatalf("duplicate Error = %v, want code %s", results[2].Error, ErrCodeInvalidRequest)
+	}
+	if !strings.Contains(results[2].Error.Message, "duplicate") {
+		t.Errorf("Message = %q, want mention of duplicate", results[2].Error.Message)
+	}
+	if executions.Load() != 2 {
+		t.Errorf("executions = %d, want 2 (duplicate never runs)", executions.Load())
+	}
+}
+
+func TestWithRejectDuplicateIDs_ResultsStayInInputOrder(t *testing.T) {
+	orch := New(WithRejectDuplicateIDs())
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "0"},
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "1"},
+		{ID: "b", TenantID: "t", Recipe: "echo", Payload: "2"},
+	})
+
+	if results[0].ID != "a" || results[1].ID != "a" || results[2].ID != "b" {
+		t.Errorf("result IDs = %s,%s,%s, want a,a,b", results[0].ID, results[1].ID, results[2].ID)
+	}
+	if results[2].Data != "2" {
+		t.Errorf("results[2].Data = %v, want 2", results[2].Data)
+	}
+}
+
+func TestWithRejectDuplicateIDs_DefaultAllowsDuplicates(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "0"},
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "1"},
+	})
+
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Errorf("results[%d].Status = %d, want 200 without the option", i, resp.Status)
+		}
+	}
+}
+
+func TestWithRejectDuplicateIDs_AppliesToExecuteBatchInto(t *testing.T) {
+	var executions atomic.Int64
+	orch := New(WithRejectDuplicateIDs())
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executions.Add(1)
+		return payload, nil
+	})
+
+	buf := GetResponses(3)
+	results := orch.ExecuteBatchInto(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "first"},
+		{ID: "b", TenantID: "t", Recipe: "echo", Payload: "other"},
+		{ID: "a", TenantID: "t", Recipe: "echo", Payload: "second"},
+	}, buf)
+
+	if results[0].Status != 200 || results[1].Status != 200 {
+		t.Errorf("unique IDs: Status = %d/%d, want 200/200", results[0].Status, results[1].Status)
+	}
+	if results[2].Status != 400 {
+		t.Errorf("duplicate: Status = %d, want 400", results[2].Status)
+	}
+	if results[2].Error == nil || results[2].Error.Code != ErrCodeInvalidRequest {
+		t.Fatalf("duplicate Error = %v, want code %s", results[2].Error, ErrCodeInvalidRequest)
+	}
+	if executions.Load() != 2 {
+		t.Errorf("executions = %d, want 2 (duplicate never runs)", executions.Load())
+	}
+	PutResponses(results)
+}
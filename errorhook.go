@@ -0,0 +1,28 @@
+package relayer
+
+import "context"
+
+// ErrorHook observes failed requests only, so alerting integrations
+// don't have to filter every completion. It receives both the sanitized
+// *Error that goes on the Response and the raw handler error that caused
+// it; cause is nil when the failure had no underlying error (e.g. an
+// unknown recipe).
+type ErrorHook interface {
+	OnError(ctx context.Context, req SubRequest, respErr *Error, cause error)
+}
+
+// WithErrorHook installs a hook invoked after each failed request, just
+// before OnComplete. Successful requests never reach it. Panics if hook
+// is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithErrorHook(&PagerHook{}))
+func WithErrorHook(hook ErrorHook) Option {
+	return func(o *Orchestrator) {
+		if hook == nil {
+			panic("error hook cannot be nil")
+		}
+		o.errorHook = hook
+	}
+}
@@ -0,0 +1,92 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// recordingErrorHook collects every failure it observes.
+type recordingErrorHook struct {
+	mu     sync.Mutex
+	codes  []string
+	causes []error
+}
+
+func (h *recordingErrorHook) OnError(ctx context.Context, req SubRequest, respErr *Error, cause error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.codes = append(h.codes, respErr.Code)
+	h.causes = append(h.causes, cause)
+}
+
+func TestErrorHook_OnlySeesFailures(t *testing.T) {
+	hook := &recordingErrorHook{}
+	orch := New(WithErrorHook(hook))
+
+	handlerErr := errors.New("db unavailable")
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, handlerErr
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+		{ID: "2", TenantID: "t", Recipe: "bad"},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.codes) != 1 {
+		t.Fatalf("hook saw %d failures, want 1", len(hook.codes))
+	}
+	if hook.codes[0] != ErrCodeRecipeExecution {
+		t.Errorf("code = %q, want %q", hook.codes[0], ErrCodeRecipeExecution)
+	}
+	if !errors.Is(hook.causes[0], handlerErr) {
+		t.Errorf("cause = %v, want the original handler error", hook.causes[0])
+	}
+}
+
+func TestErrorHook_NilCauseForUnknownRecipe(t *testing.T) {
+	hook := &recordingErrorHook{}
+	orch := New(WithErrorHook(hook))
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "missing"},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.codes) != 1 || hook.codes[0] != ErrCodeRecipeNotFound {
+		t.Fatalf("hook saw %v, want one RECIPE_NOT_FOUND", hook.codes)
+	}
+	if hook.causes[0] != nil {
+		t.Errorf("cause = %v, want nil for a lookup failure", hook.causes[0])
+	}
+}
+
+func TestErrorHook_SeesPanicCause(t *testing.T) {
+	hook := &recordingErrorHook{}
+	orch := New(WithErrorHook(hook))
+
+	orch.RegisterRecipe("explode", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "explode"},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.codes) != 1 || hook.codes[0] != ErrCodePanic {
+		t.Fatalf("hook saw %v, want one PANIC", hook.codes)
+	}
+	if hook.causes[0] == nil {
+		t.Error("cause should carry the recovered panic")
+	}
+}
@@ -0,0 +1,64 @@
+package relayer
+
+import "errors"
+
+// Sentinel errors corresponding to the ErrCode* constants. *Error values
+// unwrap to the sentinel matching their Code, so callers can branch with
+// errors.Is instead of string-matching codes:
+//
+//	if errors.Is(resp.Error, relayer.ErrTimeout) {
+//		// retry later
+//	}
+var (
+	ErrRecipeNotFound  = errors.New("recipe not found")
+	ErrTimeout         = errors.New("recipe execution timed out")
+	ErrPanic           = errors.New("recipe panicked during execution")
+	ErrRecipeExecution = errors.New("recipe execution failed")
+	ErrInvalidPayload  = errors.New("invalid payload")
+	ErrBatchTooLarge   = errors.New("batch too large")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrBudgetExceeded  = errors.New("resource budget exceeded")
+	ErrOverloaded      = errors.New("orchestrator overloaded")
+	ErrRateLimited     = errors.New("tenant rate limited")
+	ErrShuttingDown    = errors.New("orchestrator is shutting down")
+	ErrSkipped         = errors.New("request skipped after earlier failure")
+	ErrClientTimeout   = errors.New("caller deadline exceeded")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrQuotaExceeded   = errors.New("tenant quota exceeded")
+)
+
+// ErrSaturated is returned by TryExecuteBatch when no execution slot
+// frees up within the admission timeout. It is not an *Error code: the
+// batch was never admitted, so there are no per-request responses.
+var ErrSaturated = errors.New("orchestrator saturated")
+
+// ErrNoResultStore is returned by QueryResults when no ResultStore is
+// configured.
+var ErrNoResultStore = errors.New("no result store configured; use WithResultStore")
+
+// sentinelForCode maps error codes to their sentinel values for Unwrap.
+var sentinelForCode = map[string]error{
+	ErrCodeRecipeNotFound:  ErrRecipeNotFound,
+	ErrCodeTimeout:         ErrTimeout,
+	ErrCodePanic:           ErrPanic,
+	ErrCodeRecipeExecution: ErrRecipeExecution,
+	ErrCodeInvalidPayload:  ErrInvalidPayload,
+	ErrCodeBatchTooLarge:   ErrBatchTooLarge,
+	ErrCodeInvalidRequest:  ErrInvalidRequest,
+	ErrCodeBudgetExceeded:  ErrBudgetExceeded,
+	ErrCodeOverloaded:      ErrOverloaded,
+	ErrCodeRateLimited:     ErrRateLimited,
+	ErrCodeShuttingDown:    ErrShuttingDown,
+	ErrCodeSkipped:         ErrSkipped,
+	ErrCodeClientTimeout:   ErrClientTimeout,
+	ErrCodeUnauthorized:    ErrUnauthorized,
+	ErrCodeForbidden:       ErrForbidden,
+	ErrCodeQuotaExceeded:   ErrQuotaExceeded,
+}
+
+// Unwrap returns the sentinel error matching e.Code, enabling errors.Is
+// checks against the exported Err* values. Unknown codes unwrap to nil.
+func (e *Error) Unwrap() error {
+	return sentinelForCode[e.Code]
+}
@@ -0,0 +1,77 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestError_SentinelUnwrap(t *testing.T) {
+	cases := []struct {
+		code     string
+		sentinel error
+	}{
+		{ErrCodeRecipeNotFound, ErrRecipeNotFound},
+		{ErrCodeTimeout, ErrTimeout},
+		{ErrCodePanic, ErrPanic},
+		{ErrCodeRecipeExecution, ErrRecipeExecution},
+		{ErrCodeInvalidPayload, ErrInvalidPayload},
+		{ErrCodeBatchTooLarge, ErrBatchTooLarge},
+		{ErrCodeInvalidRequest, ErrInvalidRequest},
+		{ErrCodeBudgetExceeded, ErrBudgetExceeded},
+		{ErrCodeOverloaded, ErrOverloaded},
+		{ErrCodeRateLimited, ErrRateLimited},
+	}
+
+	for _, tc := range cases {
+		err := &Error{Code: tc.code, Message: "test"}
+		if !errors.Is(err, tc.sentinel) {
+			t.Errorf("errors.Is(&Error{Code: %s}, sentinel) = false, want true", tc.code)
+		}
+	}
+}
+
+func TestError_UnknownCodeUnwrapsNil(t *testing.T) {
+	err := &Error{Code: "CUSTOM", Message: "test"}
+	if errors.Is(err, ErrTimeout) {
+		t.Error("unknown code should not match any sentinel")
+	}
+}
+
+func TestError_ErrorsAs(t *testing.T) {
+	wrapped := fmt.Errorf("batch failed: %w", &Error{Code: ErrCodeTimeout, Message: "timed out"})
+
+	var structured *Error
+	if !errors.As(wrapped, &structured) {
+		t.Fatal("errors.As failed to extract *Error from wrapped chain")
+	}
+	if structured.Code != ErrCodeTimeout {
+		t.Errorf("Code = %s, want %s", structured.Code, ErrCodeTimeout)
+	}
+	if !errors.Is(wrapped, ErrTimeout) {
+		t.Error("errors.Is through wrapped chain should reach ErrTimeout sentinel")
+	}
+}
+
+func TestError_ResponseErrorsMatchSentinels(t *testing.T) {
+	orch := New(WithTimeout(10 * time.Millisecond))
+
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+		{ID: "2", TenantID: "t", Recipe: "missing"},
+	})
+
+	if !errors.Is(results[0].Error, ErrTimeout) {
+		t.Errorf("timeout response error = %+v, want ErrTimeout match", results[0].Error)
+	}
+	if !errors.Is(results[1].Error, ErrRecipeNotFound) {
+		t.Errorf("not-found response error = %+v, want ErrRecipeNotFound match", results[1].Error)
+	}
+}
@@ -108,20 +108,16 @@ func main() {
 	metricsHook := NewMetricsHook()
 	panicHook := &PanicAlertHook{}
 
-	// Create orchestrator with hooks
+	// Create orchestrator with hooks; WithExecutionHooks fans events out
+	// to every hook in order
 	orch := relayer.New(
 		relayer.WithTimeout(5*time.Second),
-		relayer.WithExecutionHook(metricsHook), // Use metrics hook for ExecutionHook
+		relayer.WithExecutionHooks(loggingHook, metricsHook),
 		relayer.WithPanicHook(panicHook),
 	)
 
-	// Note: For multiple execution hooks, you could create a CompositeHook
-	// For this example, we'll manually call logging in recipes or use metrics only
-
 	// Register recipes
 	orch.RegisterRecipe("success", func(ctx context.Context, payload interface{}) (interface{}, error) {
-		// Manually log if not using composite hook
-		loggingHook.OnStart(ctx, relayer.SubRequest{Recipe: "success"})
 		time.Sleep(10 * time.Millisecond) // Simulate work
 		return "completed", nil
 	})
@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+)
+
+// Executor is the subset of Orchestrator that services typically depend
+// on: registering recipes and executing batches. Depending on this
+// interface instead of *Orchestrator lets callers substitute a
+// MockExecutor in their own tests.
+type Executor interface {
+	// RegisterRecipe registers a handler for the given recipe name.
+	RegisterRecipe(name string, handler Handler, opts ...*RecipeOption)
+	// ExecuteBatch executes a batch of sub-requests and returns one
+	// response per request, in input order.
+	ExecuteBatch(ctx context.Context, batch []SubRequest) []Response
+}
+
+var _ Executor = (*Orchestrator)(nil)
+
+// MockExecutor is a programmable Executor for tests. Responses are
+// matched by request ID first, then by recipe name; unmatched requests
+// receive the Default response (or a 200 if none is set). All executed
+// requests are recorded and retrievable via Calls.
+type MockExecutor struct {
+	mu        sync.Mutex
+	byID      map[string]Response
+	byRecipe  map[string]Response
+	def       *Response
+	calls     []SubRequest
+	registerd []string
+}
+
+// NewMockExecutor returns an empty MockExecutor.
+func NewMockExecutor() *MockExecutor {
+	return &MockExecutor{
+		byID:     make(map[string]Response),
+		byRecipe: make(map[string]Response),
+	}
+}
+
+// RespondTo programs the response returned for the request with the
+// given ID.
+func (m *MockExecutor) RespondTo(id string, resp Response) *MockExecutor {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.byID[id] = resp
+	return m
+}
+
+// RespondToRecipe programs the response returned for any request naming
+// the given recipe, unless a per-ID response matches first.
+func (m *MockExecutor) RespondToRecipe(recipe string, resp Response) *MockExecutor {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.byRecipe[recipe] = resp
+	return m
+}
+
+// RespondDefault programs the response returned when no per-ID or
+// per-recipe response matches.
+func (m *MockExecutor) RespondDefault(resp Response) *MockExecutor {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.def = &resp
+	return m
+}
+
+// RegisterRecipe records the registration; the handler is never invoked.
+func (m *MockExecutor) RegisterRecipe(name string, handler Handler, opts ...*RecipeOption) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.registerd = append(m.registerd, name)
+}
+
+// ExecuteBatch returns the programmed response for each request, copying
+// the request's ID and TenantID onto the response so results line up the
+// way the real orchestrator's would.
+func (m *MockExecutor) ExecuteBatch(ctx context.Context, batch []SubRequest) []Response {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	results := make([]Response, len(batch))
+	for i, req := range batch {
+		m.calls = append(m.calls, req)
+
+		resp, ok := m.byID[req.ID]
+		if !ok {
+			resp, ok = m.byRecipe[req.Recipe]
+		}
+		if !ok {
+			if m.def != nil {
+				resp = *m.def
+			} else {
+				resp = Response{Status: 200}
+			}
+		}
+		resp.ID = req.ID
+		resp.TenantID = req.TenantID
+		results[i] = resp
+	}
+	return results
+}
+
+// Calls returns a copy of every sub-request passed to ExecuteBatch, in
+// execution order.
+func (m *MockExecutor) Calls() []SubRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	calls := make([]SubRequest, len(m.calls))
+	copy(calls, m.calls)
+	return calls
+}
+
+// RegisteredRecipes returns the names passed to RegisterRecipe, in
+// registration order.
+func (m *MockExecutor) RegisteredRecipes() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, len(m.registerd))
+	copy(names, m.registerd)
+	return names
+}
@@ -0,0 +1,79 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockExecutor_ProgrammedResponses(t *testing.T) {
+	mock := NewMockExecutor().
+		RespondTo("2", Response{Status: 404, Error: &Error{Code: ErrCodeRecipeNotFound, Message: "nope"}}).
+		RespondToRecipe("slow", Response{Status: 504})
+
+	results := mock.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+		{ID: "2", TenantID: "t", Recipe: "echo"},
+		{ID: "3", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("unprogrammed Status = %d, want 200", results[0].Status)
+	}
+	if results[1].Status != 404 {
+		t.Errorf("per-ID Status = %d, want 404", results[1].Status)
+	}
+	if results[2].Status != 504 {
+		t.Errorf("per-recipe Status = %d, want 504", results[2].Status)
+	}
+	if results[1].ID != "2" || results[1].TenantID != "t" {
+		t.Errorf("response identity = (%q, %q), want (2, t)", results[1].ID, results[1].TenantID)
+	}
+}
+
+func TestMockExecutor_DefaultResponse(t *testing.T) {
+	mock := NewMockExecutor().RespondDefault(Response{Status: 503})
+
+	results := mock.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "anything"},
+	})
+
+	if results[0].Status != 503 {
+		t.Errorf("default Status = %d, want 503", results[0].Status)
+	}
+}
+
+func TestMockExecutor_RecordsCalls(t *testing.T) {
+	mock := NewMockExecutor()
+
+	mock.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "a"},
+		{ID: "2", TenantID: "t", Recipe: "b"},
+	})
+	mock.RegisterRecipe("c", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	calls := mock.Calls()
+	if len(calls) != 2 || calls[0].Recipe != "a" || calls[1].Recipe != "b" {
+		t.Errorf("Calls() = %v, want recipes [a b]", calls)
+	}
+	if names := mock.RegisteredRecipes(); len(names) != 1 || names[0] != "c" {
+		t.Errorf("RegisteredRecipes() = %v, want [c]", names)
+	}
+}
+
+func TestOrchestratorImplementsExecutor(t *testing.T) {
+	var exec Executor = New()
+
+	exec.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := exec.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "x"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "x" {
+		t.Errorf("got (%d, %v), want (200, x)", results[0].Status, results[0].Data)
+	}
+}
@@ -0,0 +1,101 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ErrInjectedFault is returned by handlers failed through fault injection,
+// so tests can distinguish injected failures from real ones.
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultInjectionConfig controls probabilistic fault injection into recipe
+// execution. All probabilities are in [0, 1] and are evaluated
+// independently per request, in the order: panic, timeout, error, latency.
+// Fault injection is only active when explicitly enabled via
+// WithFaultInjection; never enable it in production traffic paths.
+type FaultInjectionConfig struct {
+	LatencyProbability float64       // Chance of injecting extra latency
+	Latency            time.Duration // Injected delay (default 100ms)
+	ErrorProbability   float64       // Chance of failing with ErrInjectedFault
+	TimeoutProbability float64       // Chance of blocking until the request deadline
+	PanicProbability   float64       // Chance of panicking inside the handler
+	Seed               int64         // Random seed (0 = time-based)
+}
+
+// faultInjector applies a FaultInjectionConfig around recipe handlers.
+type faultInjector struct {
+	cfg FaultInjectionConfig
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// WithFaultInjection enables probabilistic fault injection so users can
+// test their hooks, retries, and clients against realistic failure modes.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithFaultInjection(relayer.FaultInjectionConfig{
+//		ErrorProbability:   0.05,
+//		LatencyProbability: 0.10,
+//		Latency:            200 * time.Millisecond,
+//	}))
+func WithFaultInjection(cfg FaultInjectionConfig) Option {
+	return func(o *Orchestrator) {
+		if cfg.Latency <= 0 {
+			cfg.Latency = 100 * time.Millisecond
+		}
+		seed := cfg.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		o.faults = &faultInjector{
+			cfg: cfg,
+			rng: rand.New(rand.NewSource(seed)),
+		}
+	}
+}
+
+// roll returns true with probability p.
+func (f *faultInjector) roll(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rng.Float64() < p
+}
+
+// wrap decorates a handler with the configured faults.
+func (f *faultInjector) wrap(handler Handler) Handler {
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if f.roll(f.cfg.PanicProbability) {
+			panic("fault injection: injected panic")
+		}
+
+		if f.roll(f.cfg.TimeoutProbability) {
+			// Block until the request deadline so the normal timeout
+			// path produces the response.
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		if f.roll(f.cfg.ErrorProbability) {
+			return nil, ErrInjectedFault
+		}
+
+		if f.roll(f.cfg.LatencyProbability) {
+			select {
+			case <-time.After(f.cfg.Latency):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		return handler(ctx, payload)
+	}
+}
@@ -0,0 +1,95 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFaultInjection_AlwaysError(t *testing.T) {
+	orch := New(WithFaultInjection(FaultInjectionConfig{
+		ErrorProbability: 1.0,
+		Seed:             1,
+	}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500", results[0].Status)
+	}
+
+	if results[0].Error.Message != ErrInjectedFault.Error() {
+		t.Errorf("Error message = %q, want %q", results[0].Error.Message, ErrInjectedFault.Error())
+	}
+}
+
+func TestFaultInjection_AlwaysPanic(t *testing.T) {
+	orch := New(WithFaultInjection(FaultInjectionConfig{
+		PanicProbability: 1.0,
+		Seed:             1,
+	}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500", results[0].Status)
+	}
+
+	if results[0].Error.Code != ErrCodePanic {
+		t.Errorf("Error code = %s, want %s", results[0].Error.Code, ErrCodePanic)
+	}
+}
+
+func TestFaultInjection_AlwaysTimeout(t *testing.T) {
+	orch := New(
+		WithTimeout(20*time.Millisecond),
+		WithFaultInjection(FaultInjectionConfig{
+			TimeoutProbability: 1.0,
+			Seed:               1,
+		}),
+	)
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	if results[0].Status != 504 {
+		t.Fatalf("Status = %d, want 504", results[0].Status)
+	}
+
+	if results[0].Error.Code != ErrCodeTimeout {
+		t.Errorf("Error code = %s, want %s", results[0].Error.Code, ErrCodeTimeout)
+	}
+}
+
+func TestFaultInjection_ZeroProbabilityIsNoOp(t *testing.T) {
+	orch := New(WithFaultInjection(FaultInjectionConfig{Seed: 1}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hi"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "hi" {
+		t.Errorf("Result = %+v, want clean 200/hi", results[0])
+	}
+}
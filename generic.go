@@ -0,0 +1,80 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// PayloadTypeError reports that a request payload could not be converted
+// to the input type expected by a typed recipe handler. Requests failing
+// this conversion receive a 400 response with ErrCodeInvalidPayload
+// rather than a 500, since the fault lies with the caller.
+type PayloadTypeError struct {
+	Recipe string // Recipe that rejected the payload
+	Want   string // Expected input type name
+	Err    error  // Underlying decode error, if any
+}
+
+func (e *PayloadTypeError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("payload for recipe '%s' is not a valid %s: %v", e.Recipe, e.Want, e.Err)
+	}
+	return fmt.Sprintf("payload for recipe '%s' is not a valid %s", e.Recipe, e.Want)
+}
+
+func (e *PayloadTypeError) Unwrap() error { return e.Err }
+
+// RegisterRecipeT registers a typed handler for a recipe name. The raw
+// payload is converted to In before the handler runs: payloads that are
+// already an In are passed through directly, and anything else (notably
+// map[string]interface{} from JSON decoding) is round-tripped through
+// encoding/json. Conversion failures produce a *PayloadTypeError and a
+// 400 response, eliminating type-assertion boilerplate in handlers.
+//
+// Example:
+//
+//	type GetUserRequest struct {
+//		UserID string `json:"user_id"`
+//	}
+//
+//	relayer.RegisterRecipeT(orch, "get-user", func(ctx context.Context, req GetUserRequest) (*User, error) {
+//		return fetchUser(ctx, req.UserID)
+//	})
+func RegisterRecipeT[In, Out any](o *Orchestrator, name string, handler func(ctx context.Context, in In) (Out, error), opts ...*RecipeOption) {
+	if handler == nil {
+		panic("recipe handler cannot be nil")
+	}
+
+	RegisterRecipe(o, name, func(ctx context.Context, payload interface{}) (interface{}, error) {
+		in, err := decodePayload[In](name, payload)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, in)
+	}, opts...)
+}
+
+// decodePayload converts a raw payload into In, using a direct type
+// assertion when possible and a JSON round-trip otherwise.
+func decodePayload[In any](recipe string, payload interface{}) (In, error) {
+	var in In
+
+	if payload == nil {
+		return in, nil
+	}
+
+	if typed, ok := payload.(In); ok {
+		return typed, nil
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return in, &PayloadTypeError{Recipe: recipe, Want: fmt.Sprintf("%T", in), Err: err}
+	}
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return in, &PayloadTypeError{Recipe: recipe, Want: fmt.Sprintf("%T", in), Err: err}
+	}
+
+	return in, nil
+}
@@ -0,0 +1,89 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+type greetRequest struct {
+	Name string `json:"name"`
+}
+
+type greetResponse struct {
+	Greeting string `json:"greeting"`
+}
+
+func TestRegisterRecipeT_MapPayload(t *testing.T) {
+	orch := New()
+
+	RegisterRecipeT(orch, "greet", func(ctx context.Context, in greetRequest) (greetResponse, error) {
+		return greetResponse{Greeting: "hello " + in.Name}, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "greet", Payload: map[string]interface{}{"name": "ada"}},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200 (error: %v)", results[0].Status, results[0].Error)
+	}
+
+	resp, ok := results[0].Data.(greetResponse)
+	if !ok {
+		t.Fatalf("Data type = %T, want greetResponse", results[0].Data)
+	}
+	if resp.Greeting != "hello ada" {
+		t.Errorf("Greeting = %q, want 'hello ada'", resp.Greeting)
+	}
+}
+
+func TestRegisterRecipeT_DirectPayload(t *testing.T) {
+	orch := New()
+
+	RegisterRecipeT(orch, "greet", func(ctx context.Context, in greetRequest) (string, error) {
+		return in.Name, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "greet", Payload: greetRequest{Name: "direct"}},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "direct" {
+		t.Errorf("Response = %+v, want 200/direct", results[0])
+	}
+}
+
+func TestRegisterRecipeT_TypeMismatch(t *testing.T) {
+	orch := New()
+
+	RegisterRecipeT(orch, "count", func(ctx context.Context, in int) (int, error) {
+		return in * 2, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "count", Payload: "not a number"},
+	})
+
+	if results[0].Status != 400 {
+		t.Fatalf("Status = %d, want 400", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeInvalidPayload {
+		t.Errorf("Error = %+v, want code %s", results[0].Error, ErrCodeInvalidPayload)
+	}
+}
+
+func TestRegisterRecipeT_NilPayloadZeroValue(t *testing.T) {
+	orch := New()
+
+	RegisterRecipeT(orch, "zero", func(ctx context.Context, in greetRequest) (bool, error) {
+		return in == greetRequest{}, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "zero"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != true {
+		t.Errorf("Response = %+v, want zero-value input", results[0])
+	}
+}
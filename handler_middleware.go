@@ -0,0 +1,49 @@
+package relayer
+
+// Middleware wraps a Handler with cross-cutting behavior (auth,
+// validation, caching, ...). Unlike hooks, which are purely
+// observational, middleware can short-circuit, transform payloads, and
+// rewrite results.
+type Middleware func(Handler) Handler
+
+// WithMiddleware applies middleware around every registered recipe.
+// The first middleware listed is outermost: for WithMiddleware(a, b),
+// execution order is a -> b -> handler. Per-recipe middleware
+// (RecipeOption.Middleware) runs inside the global chain, closest to the
+// handler.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithMiddleware(authMiddleware, tracingMiddleware))
+func WithMiddleware(mw ...Middleware) Option {
+	return func(o *Orchestrator) {
+		for _, m := range mw {
+			if m != nil {
+				o.middleware = append(o.middleware, m)
+			}
+		}
+	}
+}
+
+// applyMiddleware wraps a handler with the recipe's middleware chain:
+// global middleware outermost, per-recipe middleware innermost.
+// Chains are resolved at execution time, so middleware applies to
+// recipes registered before or after the option.
+func (o *Orchestrator) applyMiddleware(recipe string, handler Handler) Handler {
+	o.mu.RLock()
+	var perRecipe []Middleware
+	if opt, exists := o.recipeOptions[recipe]; exists {
+		perRecipe = opt.Middleware
+	}
+	o.mu.RUnlock()
+
+	for i := len(perRecipe) - 1; i >= 0; i-- {
+		if perRecipe[i] != nil {
+			handler = perRecipe[i](handler)
+		}
+	}
+	for i := len(o.middleware) - 1; i >= 0; i-- {
+		handler = o.middleware[i](handler)
+	}
+	return handler
+}
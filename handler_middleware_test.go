@@ -0,0 +1,115 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWithMiddleware_OrderAndTransformation(t *testing.T) {
+	var order []string
+
+	mw := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, payload interface{}) (interface{}, error) {
+				order = append(order, name+":before")
+				result, err := next(ctx, payload)
+				order = append(order, name+":after")
+				return result, err
+			}
+		}
+	}
+
+	orch := New(WithMiddleware(mw("outer"), mw("inner")))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		order = append(order, "handler")
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "x"},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200", results[0].Status)
+	}
+
+	want := []string{"outer:before", "inner:before", "handler", "inner:after", "outer:after"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %s, want %s", i, order[i], want[i])
+		}
+	}
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	deny := func(next Handler) Handler {
+		return func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return nil, errors.New("denied by middleware")
+		}
+	}
+
+	orch := New(WithMiddleware(deny))
+
+	handlerCalled := false
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		handlerCalled = true
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500", results[0].Status)
+	}
+
+	if handlerCalled {
+		t.Error("Handler ran despite middleware short-circuit")
+	}
+}
+
+func TestRecipeOption_Middleware_PerRecipe(t *testing.T) {
+	var applied []string
+
+	tag := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, payload interface{}) (interface{}, error) {
+				applied = append(applied, name)
+				return next(ctx, payload)
+			}
+		}
+	}
+
+	orch := New(WithMiddleware(tag("global")))
+
+	orch.RegisterRecipe("wrapped", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	}, &RecipeOption{Middleware: []Middleware{tag("recipe")}})
+
+	orch.RegisterRecipe("plain", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "wrapped"},
+	})
+
+	if len(applied) != 2 || applied[0] != "global" || applied[1] != "recipe" {
+		t.Errorf("applied = %v, want [global recipe]", applied)
+	}
+
+	applied = nil
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "t", Recipe: "plain"},
+	})
+
+	if len(applied) != 1 || applied[0] != "global" {
+		t.Errorf("applied = %v, want [global]", applied)
+	}
+}
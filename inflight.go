@@ -0,0 +1,167 @@
+package relayer
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// InFlightRequest describes a request that is currently executing.
+type InFlightRequest struct {
+	ID       string        `json:"id"`
+	TenantID string        `json:"tenant_id"`
+	Recipe   string        `json:"recipe"`
+	Elapsed  time.Duration `json:"elapsed_ms"` // Time since execution began
+}
+
+// inflightGauge counts executing requests so shutdown can wait for the
+// count to reach zero. Unlike sync.WaitGroup, it is safe to wait while
+// new work keeps arriving — which Drain explicitly allows — because the
+// zero-to-positive transition happens under the same lock waiters
+// register under.
+type inflightGauge struct {
+	mu    sync.Mutex
+	count int
+	zero  chan struct{} // lazily created; closed when count reaches zero
+}
+
+func (g *inflightGauge) add() {
+	g.mu.Lock()
+	g.count++
+	g.mu.Unlock()
+}
+
+func (g *inflightGauge) done() {
+	g.mu.Lock()
+	g.count--
+	if g.count == 0 && g.zero != nil {
+		close(g.zero)
+		g.zero = nil
+	}
+	g.mu.Unlock()
+}
+
+// drained returns a channel that is closed once the in-flight count
+// next reaches zero, or an already-closed channel if it is zero now.
+func (g *inflightGauge) drained() <-chan struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.count == 0 {
+		closed := make(chan struct{})
+		close(closed)
+		return closed
+	}
+	if g.zero == nil {
+		g.zero = make(chan struct{})
+	}
+	return g.zero
+}
+
+// inflightEntry is the internal record for one executing request.
+type inflightEntry struct {
+	req     SubRequest
+	started time.Time
+	cancel  func() // Cancels the request's context (nil until execution starts)
+}
+
+// inflightTracker maintains the set of currently executing requests,
+// keyed by a monotonically increasing token so duplicate request IDs
+// don't collide.
+type inflightTracker struct {
+	mu      sync.Mutex
+	next    uint64
+	entries map[uint64]inflightEntry
+}
+
+func newInflightTracker() *inflightTracker {
+	return &inflightTracker{entries: make(map[uint64]inflightEntry)}
+}
+
+func (t *inflightTracker) add(req SubRequest) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next++
+	t.entries[t.next] = inflightEntry{req: req, started: time.Now()}
+	return t.next
+}
+
+// setCancel attaches the request's context cancel so admin tooling can
+// abort it. No-op if the request already completed.
+func (t *inflightTracker) setCancel(token uint64, cancel func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if entry, ok := t.entries[token]; ok {
+		entry.cancel = cancel
+		t.entries[token] = entry
+	}
+}
+
+// cancelByID cancels every in-flight request with the given ID,
+// reporting whether any was found.
+func (t *inflightTracker) cancelByID(id string) bool {
+	t.mu.Lock()
+	var cancels []func()
+	for _, entry := range t.entries {
+		if entry.req.ID == id && entry.cancel != nil {
+			cancels = append(cancels, entry.cancel)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	return len(cancels) > 0
+}
+
+func (t *inflightTracker) remove(token uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.entries, token)
+}
+
+func (t *inflightTracker) snapshot() []InFlightRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	requests := make([]InFlightRequest, 0, len(t.entries))
+	for _, entry := range t.entries {
+		requests = append(requests, InFlightRequest{
+			ID:       entry.req.ID,
+			TenantID: entry.req.TenantID,
+			Recipe:   entry.req.Recipe,
+			Elapsed:  now.Sub(entry.started),
+		})
+	}
+
+	// Longest-running first, so stuck recipes surface at the top
+	sort.Slice(requests, func(i, j int) bool { return requests[i].Elapsed > requests[j].Elapsed })
+	return requests
+}
+
+// InFlight returns the requests currently executing, longest-running
+// first. Intended for debug/admin endpoints to surface stuck recipes.
+//
+// Example:
+//
+//	for _, r := range orch.InFlight() {
+//		fmt.Printf("%s/%s running for %v\n", r.Recipe, r.ID, r.Elapsed)
+//	}
+func (o *Orchestrator) InFlight() []InFlightRequest {
+	return o.active.snapshot()
+}
+
+// CancelRequest cancels the context of every in-flight request with the
+// given ID, causing cooperative handlers to stop and the request to
+// complete with an error. Returns false if no such request is
+// executing. Intended for admin tooling to kill stuck requests.
+//
+// Example:
+//
+//	if !orch.CancelRequest("req-42") {
+//		log.Println("req-42 is not in flight")
+//	}
+func (o *Orchestrator) CancelRequest(id string) bool {
+	return o.active.cancelByID(id)
+}
@@ -0,0 +1,62 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestInFlight_ShowsExecutingRequests(t *testing.T) {
+	orch := New()
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "req-1", TenantID: "tenant-a", Recipe: "slow"},
+		})
+	}()
+
+	// Wait for the request to show up as in flight
+	var snapshot []InFlightRequest
+	for i := 0; i < 100; i++ {
+		snapshot = orch.InFlight()
+		if len(snapshot) == 1 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if len(snapshot) != 1 {
+		t.Fatalf("InFlight() returned %d requests, want 1", len(snapshot))
+	}
+	if snapshot[0].ID != "req-1" || snapshot[0].TenantID != "tenant-a" || snapshot[0].Recipe != "slow" {
+		t.Errorf("InFlight()[0] = %+v, want req-1/tenant-a/slow", snapshot[0])
+	}
+	if snapshot[0].Elapsed <= 0 {
+		t.Error("Elapsed should be positive for an executing request")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if remaining := orch.InFlight(); len(remaining) != 0 {
+		t.Errorf("InFlight() after completion = %v, want empty", remaining)
+	}
+}
+
+func TestInFlight_Empty(t *testing.T) {
+	orch := New()
+
+	if got := orch.InFlight(); len(got) != 0 {
+		t.Errorf("InFlight() on idle orchestrator = %v, want empty", got)
+	}
+}
@@ -0,0 +1,248 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JobStatus is the lifecycle state of an asynchronously submitted batch.
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"   // Persisted, not yet executing
+	JobScheduled JobStatus = "scheduled" // Waiting for its RunAt time
+	JobRunning   JobStatus = "running"   // Currently executing
+	JobCompleted JobStatus = "completed" // Finished; Results are final
+	JobCancelled JobStatus = "cancelled" // Cancelled before it started
+)
+
+// Job is a persisted asynchronous batch: the submitted requests, their
+// results once available, and lifecycle metadata.
+type Job struct {
+	BatchID  string       `json:"batch_id"`
+	Status   JobStatus    `json:"status"`
+	Requests []SubRequest `json:"requests"`
+	Results  []Response   `json:"results,omitempty"`
+	// Checkpoint holds per-request results persisted as they complete,
+	// keyed by request ID. A restarted process resumes only the
+	// requests missing from it (see ResumeJobs).
+	Checkpoint  map[string]Response `json:"checkpoint,omitempty"`
+	SubmittedAt time.Time           `json:"submitted_at"`
+	RunAt       time.Time           `json:"run_at,omitempty"` // Scheduled start time (ScheduleBatch only)
+	CompletedAt time.Time           `json:"completed_at,omitempty"`
+}
+
+// ErrJobNotFound is returned by JobStore lookups for unknown batch IDs.
+var ErrJobNotFound = errors.New("job not found")
+
+// JobStore persists asynchronously submitted batches and their results.
+// Implementations must be safe for concurrent use. The library ships an
+// in-memory store and a durable file-backed store; external stores
+// (SQLite, Redis, ...) can implement the same interface.
+type JobStore interface {
+	// SaveJob persists a new job.
+	SaveJob(job *Job) error
+
+	// UpdateJob replaces the stored state of an existing job.
+	UpdateJob(job *Job) error
+
+	// GetJob returns the job for a batch ID, or ErrJobNotFound.
+	GetJob(batchID string) (*Job, error)
+
+	// ListJobs returns jobs containing at least one request for the
+	// tenant; an empty tenant ID returns all jobs.
+	ListJobs(tenantID string) ([]*Job, error)
+}
+
+// MemoryJobStore is a JobStore for single-process deployments and tests.
+// Jobs do not survive restarts.
+type MemoryJobStore struct {
+	mu   sync.RWMutex
+	jobs map[string]*Job
+}
+
+// NewMemoryJobStore creates an empty in-memory job store.
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{jobs: make(map[string]*Job)}
+}
+
+// SaveJob persists a new job.
+func (s *MemoryJobStore) SaveJob(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.BatchID] = cloneJob(job)
+	return nil
+}
+
+// UpdateJob replaces the stored state of an existing job.
+func (s *MemoryJobStore) UpdateJob(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.jobs[job.BatchID]; !exists {
+		return ErrJobNotFound
+	}
+	s.jobs[job.BatchID] = cloneJob(job)
+	return nil
+}
+
+// GetJob returns the job for a batch ID, or ErrJobNotFound.
+func (s *MemoryJobStore) GetJob(batchID string) (*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, exists := s.jobs[batchID]
+	if !exists {
+		return nil, ErrJobNotFound
+	}
+	return cloneJob(job), nil
+}
+
+// ListJobs returns jobs matching the tenant filter.
+func (s *MemoryJobStore) ListJobs(tenantID string) ([]*Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var jobs []*Job
+	for _, job := range s.jobs {
+		if jobMatchesTenant(job, tenantID) {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+	return jobs, nil
+}
+
+// FileJobStore is a durable JobStore writing one JSON file per job under
+// a directory, so submitted batches and their results survive process
+// restarts. Writes go through a temp file and rename for crash safety.
+type FileJobStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileJobStore creates (if needed) the directory and returns a
+// file-backed job store.
+func NewFileJobStore(dir string) (*FileJobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating job store directory: %w", err)
+	}
+	return &FileJobStore{dir: dir}, nil
+}
+
+func (s *FileJobStore) path(batchID string) string {
+	// Batch IDs are hex, but guard against path separators anyway.
+	safe := strings.ReplaceAll(batchID, string(os.PathSeparator), "_")
+	return filepath.Join(s.dir, safe+".json")
+}
+
+func (s *FileJobStore) write(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("encoding job %s: %w", job.BatchID, err)
+	}
+
+	tmp := s.path(job.BatchID) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing job %s: %w", job.BatchID, err)
+	}
+	return os.Rename(tmp, s.path(job.BatchID))
+}
+
+// SaveJob persists a new job.
+func (s *FileJobStore) SaveJob(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(job)
+}
+
+// UpdateJob replaces the stored state of an existing job.
+func (s *FileJobStore) UpdateJob(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := os.Stat(s.path(job.BatchID)); err != nil {
+		return ErrJobNotFound
+	}
+	return s.write(job)
+}
+
+// GetJob returns the job for a batch ID, or ErrJobNotFound.
+func (s *FileJobStore) GetJob(batchID string) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path(batchID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("decoding job %s: %w", batchID, err)
+	}
+	return &job, nil
+}
+
+// ListJobs returns jobs matching the tenant filter.
+func (s *FileJobStore) ListJobs(tenantID string) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []*Job
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var job Job
+		if err := json.Unmarshal(data, &job); err != nil {
+			continue
+		}
+		if jobMatchesTenant(&job, tenantID) {
+			jobs = append(jobs, &job)
+		}
+	}
+	return jobs, nil
+}
+
+// cloneJob deep-copies the slices so store-internal state can't be
+// mutated through returned jobs.
+func cloneJob(job *Job) *Job {
+	clone := *job
+	clone.Requests = append([]SubRequest(nil), job.Requests...)
+	clone.Results = append([]Response(nil), job.Results...)
+	if job.Checkpoint != nil {
+		clone.Checkpoint = make(map[string]Response, len(job.Checkpoint))
+		for id, resp := range job.Checkpoint {
+			clone.Checkpoint[id] = resp
+		}
+	}
+	return &clone
+}
+
+// jobMatchesTenant reports whether a job contains any request for the
+// tenant; an empty tenant matches everything.
+func jobMatchesTenant(job *Job, tenantID string) bool {
+	if tenantID == "" {
+		return true
+	}
+	for _, req := range job.Requests {
+		if req.TenantID == tenantID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func testJobStores(t *testing.T) map[string]JobStore {
+	fileStore, err := NewFileJobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileJobStore failed: %v", err)
+	}
+	return map[string]JobStore{
+		"memory": NewMemoryJobStore(),
+		"file":   fileStore,
+	}
+}
+
+func TestJobStore_SaveGetUpdate(t *testing.T) {
+	for name, store := range testJobStores(t) {
+		t.Run(name, func(t *testing.T) {
+			job := &Job{
+				BatchID:     "batch-1",
+				Status:      JobPending,
+				Requests:    []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "r"}},
+				SubmittedAt: time.Now(),
+			}
+
+			if err := store.SaveJob(job); err != nil {
+				t.Fatalf("SaveJob failed: %v", err)
+			}
+
+			got, err := store.GetJob("batch-1")
+			if err != nil {
+				t.Fatalf("GetJob failed: %v", err)
+			}
+			if got.Status != JobPending || len(got.Requests) != 1 {
+				t.Errorf("GetJob = %+v, want pending with 1 request", got)
+			}
+
+			job.Status = JobCompleted
+			job.Results = []Response{{ID: "1", Status: 200}}
+			if err := store.UpdateJob(job); err != nil {
+				t.Fatalf("UpdateJob failed: %v", err)
+			}
+
+			got, _ = store.GetJob("batch-1")
+			if got.Status != JobCompleted || len(got.Results) != 1 {
+				t.Errorf("After update = %+v, want completed with results", got)
+			}
+
+			if _, err := store.GetJob("missing"); !errors.Is(err, ErrJobNotFound) {
+				t.Errorf("GetJob(missing) err = %v, want ErrJobNotFound", err)
+			}
+
+			if err := store.UpdateJob(&Job{BatchID: "missing"}); !errors.Is(err, ErrJobNotFound) {
+				t.Errorf("UpdateJob(missing) err = %v, want ErrJobNotFound", err)
+			}
+		})
+	}
+}
+
+func TestJobStore_ListJobsByTenant(t *testing.T) {
+	for name, store := range testJobStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store.SaveJob(&Job{
+				BatchID:  "batch-a",
+				Requests: []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "r"}},
+			})
+			store.SaveJob(&Job{
+				BatchID:  "batch-b",
+				Requests: []SubRequest{{ID: "2", TenantID: "tenant-b", Recipe: "r"}},
+			})
+
+			jobs, err := store.ListJobs("tenant-a")
+			if err != nil {
+				t.Fatalf("ListJobs failed: %v", err)
+			}
+			if len(jobs) != 1 || jobs[0].BatchID != "batch-a" {
+				t.Errorf("ListJobs(tenant-a) = %+v, want only batch-a", jobs)
+			}
+
+			all, _ := store.ListJobs("")
+			if len(all) != 2 {
+				t.Errorf("ListJobs(\"\") returned %d jobs, want 2", len(all))
+			}
+		})
+	}
+}
+
+func TestFileJobStore_SurvivesReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	store, _ := NewFileJobStore(dir)
+	store.SaveJob(&Job{
+		BatchID:  "persistent",
+		Status:   JobCompleted,
+		Requests: []SubRequest{{ID: "1", TenantID: "t", Recipe: "r"}},
+		Results:  []Response{{ID: "1", Status: 200}},
+	})
+
+	// Simulate a restart by opening a fresh store on the same directory.
+	reopened, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatalf("Reopening store failed: %v", err)
+	}
+
+	job, err := reopened.GetJob("persistent")
+	if err != nil {
+		t.Fatalf("GetJob after reopen failed: %v", err)
+	}
+	if job.Status != JobCompleted || len(job.Results) != 1 {
+		t.Errorf("Reloaded job = %+v, want completed with results", job)
+	}
+}
+
+func TestSubmitBatch_AsyncExecution(t *testing.T) {
+	orch := New(WithJobStore(NewMemoryJobStore()))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batchID, err := orch.SubmitBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hi"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitBatch failed: %v", err)
+	}
+
+	// Poll until completion.
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		job, err := orch.GetJob(batchID)
+		if err != nil {
+			t.Fatalf("GetJob failed: %v", err)
+		}
+		if job.Status == JobCompleted {
+			if len(job.Results) != 1 || job.Results[0].Status != 200 {
+				t.Errorf("Results = %+v, want one 200", job.Results)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Job never completed; status = %s", job.Status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSubmitBatch_RequiresStore(t *testing.T) {
+	orch := New()
+
+	if _, err := orch.SubmitBatch(context.Background(), []SubRequest{{ID: "1"}}); !errors.Is(err, ErrNoJobStore) {
+		t.Errorf("err = %v, want ErrNoJobStore", err)
+	}
+}
@@ -0,0 +1,86 @@
+package relayer
+
+import "context"
+
+// LateResultHook observes results from detached handlers: executions
+// that already answered 504 but were allowed to finish in the
+// background (see RecipeOption.DetachOnTimeout). The hook receives the
+// response the request would have gotten, so expensive late work can be
+// cached or logged instead of discarded. Called from the detached
+// handler's goroutine after it returns.
+type LateResultHook interface {
+	OnLateResult(req SubRequest, resp Response)
+}
+
+// WithLateResultHook sets the hook receiving detached handlers' late
+// results. Pass nil to disable (the default).
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithLateResultHook(&CacheLateResults{cache}))
+func WithLateResultHook(hook LateResultHook) Option {
+	return func(o *Orchestrator) {
+		o.lateResults = hook
+	}
+}
+
+// detachOnTimeout reports whether the recipe's handler runs supervised:
+// either the recipe opted in via DetachOnTimeout or the orchestrator
+// supervises every handler via WithHandlerSupervision.
+func (o *Orchestrator) detachOnTimeout(recipe string) bool {
+	if o.supervised {
+		return true
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	opt := o.recipeOptions[recipe]
+	return opt != nil && opt.DetachOnTimeout
+}
+
+// LeakedHandlers reports how many detached handlers are still running
+// past their deadline: goroutines whose requests already answered 504
+// but whose handlers have not yet returned. A steadily growing value
+// points at handlers that never check ctx.
+func (o *Orchestrator) LeakedHandlers() int64 {
+	return o.leaked.Load()
+}
+
+// executeDetached supervises a handler in its own goroutine: if the
+// deadline fires first, the request gets its 504 immediately while the
+// handler keeps running, and the eventual result is delivered to the
+// late-result hook.
+func (o *Orchestrator) executeDetached(ctx context.Context, req SubRequest, run func() (interface{}, error)) (Response, error) {
+	type handlerResult struct {
+		data interface{}
+		err  error
+	}
+	done := make(chan handlerResult, 1)
+
+	go func() {
+		data, err := run()
+		done <- handlerResult{data: data, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		if timedOut(ctx) {
+			return timeoutResponse(req), result.err
+		}
+		return o.buildResponse(req, result.data, result.err)
+
+	case <-ctx.Done():
+		// Detach: answer now, deliver the handler's eventual result to
+		// the hook from its own goroutine. Until then the handler counts
+		// as leaked.
+		o.leaked.Add(1)
+		go func() {
+			result := <-done
+			o.leaked.Add(-1)
+			if o.lateResults != nil {
+				resp, _ := o.buildResponse(req, result.data, result.err)
+				o.lateResults.OnLateResult(o.redactRequest(req), resp)
+			}
+		}()
+		return timeoutResponse(req), nil
+	}
+}
@@ -0,0 +1,106 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingLateHook captures late results for assertions.
+type recordingLateHook struct {
+	mu      sync.Mutex
+	results []Response
+	done    chan struct{}
+}
+
+func (h *recordingLateHook) OnLateResult(req SubRequest, resp Response) {
+	h.mu.Lock()
+	h.results = append(h.results, resp)
+	h.mu.Unlock()
+	close(h.done)
+}
+
+func TestDetachOnTimeout_Returns504AndDeliversLateResult(t *testing.T) {
+	hook := &recordingLateHook{done: make(chan struct{})}
+	orch := New(WithTimeout(20*time.Millisecond), WithLateResultHook(hook))
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		// Ignores ctx entirely, like a blocking library call.
+		<-release
+		return "expensive result", nil
+	}, &RecipeOption{DetachOnTimeout: true})
+
+	start := time.Now()
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Status != 504 || results[0].Error.Code != ErrCodeTimeout {
+		t.Fatalf("got (%d, %v), want an immediate 504 TIMEOUT", results[0].Status, results[0].Error)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("ExecuteBatch blocked %v, want a return near the 20ms deadline", elapsed)
+	}
+
+	close(release)
+	select {
+	case <-hook.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("late result never delivered")
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.results) != 1 || hook.results[0].Data != "expensive result" {
+		t.Errorf("late results = %+v, want the handler's eventual result", hook.results)
+	}
+}
+
+func TestDetachOnTimeout_FastHandlerUnaffected(t *testing.T) {
+	hook := &recordingLateHook{done: make(chan struct{})}
+	orch := New(WithTimeout(time.Second), WithLateResultHook(hook))
+
+	orch.RegisterRecipe("fast", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	}, &RecipeOption{DetachOnTimeout: true})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fast"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "ok" {
+		t.Errorf("got (%d, %v), want the normal (200, ok)", results[0].Status, results[0].Data)
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.results) != 0 {
+		t.Errorf("late results = %+v, want none for an in-deadline completion", hook.results)
+	}
+}
+
+func TestWithoutDetach_TimeoutStillWaitsForHandler(t *testing.T) {
+	orch := New(WithTimeout(20 * time.Millisecond))
+
+	finished := make(chan struct{})
+	orch.RegisterRecipe("stubborn", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(60 * time.Millisecond)
+		close(finished)
+		return nil, ctx.Err()
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "stubborn"},
+	})
+
+	select {
+	case <-finished:
+	default:
+		t.Error("cooperative path returned before the handler finished")
+	}
+	if results[0].Status != 504 {
+		t.Errorf("Status = %d, want 504", results[0].Status)
+	}
+}
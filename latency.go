@@ -0,0 +1,142 @@
+package relayer
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencyWindowSize is the number of recent samples kept per recipe.
+const latencyWindowSize = 256
+
+// latencyWindow is a fixed-size ring buffer of recent execution durations.
+type latencyWindow struct {
+	samples [latencyWindowSize]time.Duration
+	next    int
+	count   int
+}
+
+func (w *latencyWindow) record(d time.Duration) {
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % latencyWindowSize
+	if w.count < latencyWindowSize {
+		w.count++
+	}
+}
+
+// quantile returns the duration at quantile q over the recorded samples.
+func (w *latencyWindow) quantile(q float64) time.Duration {
+	if w.count == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, w.count)
+	copy(sorted, w.samples[:w.count])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return percentile(sorted, q)
+}
+
+// latencyTracker maintains per-recipe latency histories.
+// It backs adaptive timeouts and is cheap enough to leave always-on
+// once enabled.
+type latencyTracker struct {
+	mu      sync.Mutex
+	windows map[string]*latencyWindow
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{windows: make(map[string]*latencyWindow)}
+}
+
+func (t *latencyTracker) record(recipe string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, exists := t.windows[recipe]
+	if !exists {
+		w = &latencyWindow{}
+		t.windows[recipe] = w
+	}
+	w.record(d)
+}
+
+// observed returns the quantile q latency for a recipe and the number of
+// samples it is based on.
+func (t *latencyTracker) observed(recipe string, q float64) (time.Duration, int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, exists := t.windows[recipe]
+	if !exists {
+		return 0, 0
+	}
+	return w.quantile(q), w.count
+}
+
+// AdaptiveTimeoutConfig controls timeout auto-tuning from latency history.
+// When enabled, a recipe's effective timeout becomes its observed p99
+// latency multiplied by Factor, clamped to [Min, Max]. Until MinSamples
+// executions have been observed, the configured default applies.
+//
+// An explicit RecipeOption.Timeout always takes precedence over the
+// adaptive value.
+type AdaptiveTimeoutConfig struct {
+	Factor     float64       // Multiplier applied to observed p99 (default 1.5)
+	Min        time.Duration // Lower bound on the adaptive timeout (default 10ms)
+	Max        time.Duration // Upper bound on the adaptive timeout (default: orchestrator timeout)
+	MinSamples int           // Samples required before adapting (default 20)
+}
+
+// WithAdaptiveTimeouts enables latency-based timeout auto-tuning.
+// Timeouts converge toward (observed p99 × Factor) per recipe, bounded
+// by Min/Max, so operators don't have to hand-set timeouts for dozens
+// of recipes.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithAdaptiveTimeouts(relayer.AdaptiveTimeoutConfig{
+//		Factor: 2.0,
+//		Min:    50 * time.Millisecond,
+//		Max:    10 * time.Second,
+//	}))
+func WithAdaptiveTimeouts(cfg AdaptiveTimeoutConfig) Option {
+	return func(o *Orchestrator) {
+		if cfg.Factor <= 0 {
+			cfg.Factor = 1.5
+		}
+		if cfg.Min <= 0 {
+			cfg.Min = 10 * time.Millisecond
+		}
+		if cfg.MinSamples <= 0 {
+			cfg.MinSamples = 20
+		}
+		o.adaptiveTimeouts = &cfg
+		if o.latencies == nil {
+			o.latencies = newLatencyTracker()
+		}
+	}
+}
+
+// adaptiveTimeout returns the auto-tuned timeout for a recipe, or
+// (0, false) when not enough history exists or adaptation is disabled.
+func (o *Orchestrator) adaptiveTimeout(recipe string) (time.Duration, bool) {
+	cfg := o.adaptiveTimeouts
+	if cfg == nil || o.latencies == nil {
+		return 0, false
+	}
+
+	p99, samples := o.latencies.observed(recipe, 0.99)
+	if samples < cfg.MinSamples {
+		return 0, false
+	}
+
+	timeout := time.Duration(float64(p99) * cfg.Factor)
+	if timeout < cfg.Min {
+		timeout = cfg.Min
+	}
+	max := cfg.Max
+	if max <= 0 {
+		max = o.timeout
+	}
+	if timeout > max {
+		timeout = max
+	}
+	return timeout, true
+}
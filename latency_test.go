@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLatencyWindow_Quantile(t *testing.T) {
+	w := &latencyWindow{}
+
+	for i := 1; i <= 100; i++ {
+		w.record(time.Duration(i) * time.Millisecond)
+	}
+
+	p50 := w.quantile(0.50)
+	if p50 < 45*time.Millisecond || p50 > 55*time.Millisecond {
+		t.Errorf("p50 = %v, want ~50ms", p50)
+	}
+
+	p99 := w.quantile(0.99)
+	if p99 < 95*time.Millisecond {
+		t.Errorf("p99 = %v, want >= 95ms", p99)
+	}
+}
+
+func TestAdaptiveTimeout_RequiresMinSamples(t *testing.T) {
+	orch := New(WithAdaptiveTimeouts(AdaptiveTimeoutConfig{MinSamples: 10}))
+
+	if _, ok := orch.adaptiveTimeout("unknown"); ok {
+		t.Error("Adaptive timeout should not apply without samples")
+	}
+
+	for i := 0; i < 9; i++ {
+		orch.latencies.record("r", time.Millisecond)
+	}
+	if _, ok := orch.adaptiveTimeout("r"); ok {
+		t.Error("Adaptive timeout should not apply below MinSamples")
+	}
+
+	orch.latencies.record("r", time.Millisecond)
+	if _, ok := orch.adaptiveTimeout("r"); !ok {
+		t.Error("Adaptive timeout should apply at MinSamples")
+	}
+}
+
+func TestAdaptiveTimeout_Bounds(t *testing.T) {
+	orch := New(WithAdaptiveTimeouts(AdaptiveTimeoutConfig{
+		Factor:     2.0,
+		Min:        100 * time.Millisecond,
+		Max:        200 * time.Millisecond,
+		MinSamples: 1,
+	}))
+
+	// Tiny latencies: clamped up to Min.
+	orch.latencies.record("fast", time.Microsecond)
+	timeout, ok := orch.adaptiveTimeout("fast")
+	if !ok || timeout != 100*time.Millisecond {
+		t.Errorf("Adaptive timeout = %v, want 100ms (Min clamp)", timeout)
+	}
+
+	// Huge latencies: clamped down to Max.
+	orch.latencies.record("slow", time.Minute)
+	timeout, ok = orch.adaptiveTimeout("slow")
+	if !ok || timeout != 200*time.Millisecond {
+		t.Errorf("Adaptive timeout = %v, want 200ms (Max clamp)", timeout)
+	}
+}
+
+func TestAdaptiveTimeout_ExplicitTimeoutWins(t *testing.T) {
+	orch := New(WithAdaptiveTimeouts(AdaptiveTimeoutConfig{
+		Factor:     1.0,
+		Min:        time.Millisecond,
+		Max:        5 * time.Millisecond,
+		MinSamples: 1,
+	}))
+
+	// Seed history so the adaptive timeout would be ~5ms, then rely on
+	// the explicit 500ms recipe timeout to let the request finish.
+	orch.latencies.record("sleepy", time.Millisecond)
+
+	orch.RegisterRecipe("sleepy", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(30 * time.Millisecond)
+		return "ok", nil
+	}, &RecipeOption{Timeout: 500 * time.Millisecond})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "sleepy"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 (explicit timeout should override adaptive)", results[0].Status)
+	}
+}
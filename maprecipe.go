@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// defaultMapConcurrency bounds MapRecipe's fan-out when no explicit
+// limit is given.
+const defaultMapConcurrency = 8
+
+// MapError aggregates per-element failures from a MapRecipe fan-out.
+// Elements maps array index to the element's error; indices absent from
+// the map succeeded. Total is the element count of the input array.
+type MapError struct {
+	Elements map[int]error
+	Total    int
+}
+
+func (e *MapError) Error() string {
+	return fmt.Sprintf("map recipe: %d of %d elements failed", len(e.Elements), e.Total)
+}
+
+// MapRecipe wraps a handler so that an array payload fans out to one
+// inner invocation per element, with bounded concurrency, and the
+// results are aggregated into a single slice in element order. A
+// non-array payload is passed through to the inner handler unchanged,
+// so the same recipe serves both shapes. If any element fails, the
+// whole request fails with a *MapError detailing which indices failed.
+//
+// The concurrency bound defaults to 8; pass an explicit positive bound
+// to override. Panics if inner is nil or the bound is not positive,
+// matching option validation elsewhere.
+//
+// Example:
+//
+//	orch.RegisterRecipe("resize-images", relayer.MapRecipe(resizeOne, 4))
+func MapRecipe(inner Handler, concurrency ...int) Handler {
+	if inner == nil {
+		panic("recipe handler cannot be nil")
+	}
+	bound := defaultMapConcurrency
+	if len(concurrency) > 0 {
+		bound = concurrency[0]
+		if bound <= 0 {
+			panic("map recipe concurrency must be positive")
+		}
+	}
+
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		elements, ok := payload.([]interface{})
+		if !ok {
+			return inner(ctx, payload)
+		}
+
+		results := make([]interface{}, len(elements))
+		errs := make(map[int]error)
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, bound)
+
+		for i, element := range elements {
+			wg.Add(1)
+			go func(i int, element interface{}) {
+				defer wg.Done()
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					mu.Lock()
+					errs[i] = ctx.Err()
+					mu.Unlock()
+					return
+				}
+
+				result, err := inner(ctx, element)
+				mu.Lock()
+				if err != nil {
+					errs[i] = err
+				} else {
+					results[i] = result
+				}
+				mu.Unlock()
+			}(i, element)
+		}
+		wg.Wait()
+
+		if len(errs) > 0 {
+			return nil, &MapError{Elements: errs, Total: len(elements)}
+		}
+		return results, nil
+	}
+}
@@ -0,0 +1,116 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestMapRecipe_FansOutPerElement(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("double-all", MapRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) * 2, nil
+	}))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "double-all", Payload: []interface{}{1, 2, 3}},
+	})
+
+	want := []interface{}{2, 4, 6}
+	if !reflect.DeepEqual(results[0].Data, want) {
+		t.Errorf("Data = %v, want %v in element order", results[0].Data, want)
+	}
+}
+
+func TestMapRecipe_NonArrayPayloadPassesThrough(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("double", MapRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) * 2, nil
+	}))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "double", Payload: 21},
+	})
+
+	if results[0].Data != 42 {
+		t.Errorf("Data = %v, want 42 from the pass-through path", results[0].Data)
+	}
+}
+
+func TestMapRecipe_AggregatesElementErrors(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("picky", MapRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if payload.(int)%2 != 0 {
+			return nil, errors.New("odd element")
+		}
+		return payload, nil
+	}))
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "picky", Payload: []interface{}{1, 2, 3, 4}},
+	})
+
+	if results[0].Status != 500 || results[0].Error == nil {
+		t.Fatalf("got (%d, %v), want a 500 aggregating element failures", results[0].Status, results[0].Error)
+	}
+}
+
+func TestMapRecipe_BoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	current, peak := 0, 0
+
+	handler := MapRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		current++
+		if current > peak {
+			peak = current
+		}
+		mu.Unlock()
+
+		mu.Lock()
+		current--
+		mu.Unlock()
+		return payload, nil
+	}, 2)
+
+	elements := make([]interface{}, 20)
+	for i := range elements {
+		elements[i] = i
+	}
+	if _, err := handler(context.Background(), elements); err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+
+	if peak > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", peak)
+	}
+}
+
+func TestMapError_ReportsFailedIndices(t *testing.T) {
+	handler := MapRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if payload.(int) == 1 {
+			return nil, errors.New("bad")
+		}
+		return payload, nil
+	})
+
+	_, err := handler(context.Background(), []interface{}{0, 1, 2})
+	var mapErr *MapError
+	if !errors.As(err, &mapErr) {
+		t.Fatalf("error = %v, want *MapError", err)
+	}
+	if len(mapErr.Elements) != 1 || mapErr.Elements[1] == nil || mapErr.Total != 3 {
+		t.Errorf("MapError = %+v, want index 1 failed out of 3", mapErr)
+	}
+}
+
+func TestMapRecipe_NilHandlerPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for nil inner handler")
+		}
+	}()
+	MapRecipe(nil)
+}
@@ -0,0 +1,84 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+)
+
+// responseMeta collects execution annotations (e.g. which branch a
+// composite's conditional took) while a request runs. It is installed
+// into the request context by processRequest and copied onto the
+// Response afterwards: into Response.Meta on success, and merged into
+// Error.Details on failure so the annotations travel with the error.
+type responseMeta struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+type responseMetaKey struct{}
+
+// withResponseMeta installs a fresh annotation collector into ctx.
+func withResponseMeta(ctx context.Context) (context.Context, *responseMeta) {
+	meta := &responseMeta{}
+	return context.WithValue(ctx, responseMetaKey{}, meta), meta
+}
+
+// annotateResponse records a key/value annotation for the current
+// request, if a collector is present. Safe to call from any goroutine
+// executing under the request context.
+func annotateResponse(ctx context.Context, key string, value interface{}) {
+	meta, ok := ctx.Value(responseMetaKey{}).(*responseMeta)
+	if !ok {
+		return
+	}
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	if meta.values == nil {
+		meta.values = make(map[string]interface{})
+	}
+	meta.values[key] = value
+}
+
+// appendResponseMeta appends a value to a slice-valued annotation,
+// preserving insertion order across calls.
+func appendResponseMeta(ctx context.Context, key string, value interface{}) {
+	meta, ok := ctx.Value(responseMetaKey{}).(*responseMeta)
+	if !ok {
+		return
+	}
+	meta.mu.Lock()
+	defer meta.mu.Unlock()
+	if meta.values == nil {
+		meta.values = make(map[string]interface{})
+	}
+	list, _ := meta.values[key].([]interface{})
+	meta.values[key] = append(list, value)
+}
+
+// apply copies collected annotations onto the response: Error.Details
+// for failures, Response.Meta otherwise. No-op when nothing was
+// recorded.
+func (m *responseMeta) apply(resp *Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.values) == 0 {
+		return
+	}
+
+	if resp.Error != nil {
+		if resp.Error.Details == nil {
+			resp.Error.Details = make(map[string]interface{}, len(m.values))
+		}
+		for k, v := range m.values {
+			resp.Error.Details[k] = v
+		}
+		return
+	}
+
+	if resp.Meta == nil {
+		resp.Meta = make(map[string]interface{}, len(m.values))
+	}
+	for k, v := range m.values {
+		resp.Meta[k] = v
+	}
+}
@@ -52,6 +52,19 @@ type PanicHook interface {
 	OnPanic(ctx context.Context, req SubRequest, recovered interface{})
 }
 
+// PanicStackHook is an optional extension of PanicHook. When the
+// configured panic hook also implements it, the orchestrator calls
+// OnPanicWithStack instead of OnPanic, passing the stack captured with
+// debug.Stack() on the panicking goroutine — capturing it inside the
+// hook would record the wrong goroutine's stack.
+type PanicStackHook interface {
+	PanicHook
+
+	// OnPanicWithStack is called when a recipe panics, with the stack
+	// trace captured at the panic site.
+	OnPanicWithStack(ctx context.Context, req SubRequest, recovered interface{}, stack []byte)
+}
+
 // NoOpHook provides default no-op implementations of all hook interfaces.
 // Useful as a base for partial hook implementations or as a default.
 type NoOpHook struct{}
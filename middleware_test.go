@@ -180,3 +180,43 @@ func TestHooks_ThreadSafety(t *testing.T) {
 		t.Errorf("Expected 100 OnComplete calls, got %d", len(completeCalls))
 	}
 }
+
+func TestCompositeExecutionHook_FanOutInOrder(t *testing.T) {
+	var calls []string
+
+	hookA := &orderedHook{name: "a", calls: &calls}
+	hookB := &orderedHook{name: "b", calls: &calls}
+
+	orch := New(WithExecutionHooks(hookA, nil, hookB))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	want := []string{"a:start", "b:start", "a:complete", "b:complete"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("calls[%d] = %s, want %s", i, calls[i], want[i])
+		}
+	}
+}
+
+type orderedHook struct {
+	name  string
+	calls *[]string
+}
+
+func (h *orderedHook) OnStart(ctx context.Context, req SubRequest) {
+	*h.calls = append(*h.calls, h.name+":start")
+}
+
+func (h *orderedHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	*h.calls = append(*h.calls, h.name+":complete")
+}
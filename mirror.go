@@ -0,0 +1,112 @@
+package relayer
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+)
+
+// MirrorDiff describes a divergence between the primary response and
+// the mirrored one. The request payload is redacted the same way hook
+// payloads are.
+type MirrorDiff struct {
+	Request SubRequest
+	Primary Response
+	Shadow  Response
+}
+
+// MirrorDiffHook receives diffs from mirrored requests whose shadow
+// response diverged from the primary (different status, error code, or
+// data). Called from the mirroring goroutine, off the request path.
+type MirrorDiffHook interface {
+	OnMirrorDiff(diff MirrorDiff)
+}
+
+// MirrorDiffHookFunc adapts a plain function to the MirrorDiffHook
+// interface.
+type MirrorDiffHookFunc func(diff MirrorDiff)
+
+// OnMirrorDiff implements MirrorDiffHook.
+func (f MirrorDiffHookFunc) OnMirrorDiff(diff MirrorDiff) { f(diff) }
+
+// WithMirror asynchronously replays the given percentage of executed
+// requests (0-100) against a secondary executor — typically an
+// orchestrator running new recipe versions — without affecting primary
+// latency: the shadow call runs on its own goroutine after the primary
+// response is already decided, and its result is never returned to the
+// caller. Divergent shadow responses are reported to the hook set via
+// WithMirrorDiffHook. Panics if secondary is nil or percent is outside
+// [0, 100].
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithMirror(candidate, 5), // shadow 5% of traffic
+//		relayer.WithMirrorDiffHook(relayer.MirrorDiffHookFunc(logDiff)),
+//	)
+func WithMirror(secondary Executor, percent float64) Option {
+	return func(o *Orchestrator) {
+		if secondary == nil {
+			panic("mirror executor cannot be nil")
+		}
+		if percent < 0 || percent > 100 {
+			panic("mirror percent must be between 0 and 100")
+		}
+		o.mirror = secondary
+		o.mirrorPercent = percent
+	}
+}
+
+// WithMirrorDiffHook sets the hook receiving response diffs from
+// mirrored requests. Pass nil to disable (the default); mirroring then
+// still replays traffic, which is useful for load-testing a candidate.
+func WithMirrorDiffHook(hook MirrorDiffHook) Option {
+	return func(o *Orchestrator) {
+		o.mirrorDiffs = hook
+	}
+}
+
+// mirrorSampled reports whether this request falls into the mirrored
+// fraction.
+func (o *Orchestrator) mirrorSampled() bool {
+	return rand.Float64()*100 < o.mirrorPercent
+}
+
+// mirrorRequest replays the request against the secondary executor and
+// reports a diff when the shadow response diverges. Runs on its own
+// goroutine; the context is detached from the primary's cancellation so
+// a completed primary doesn't cut the shadow short.
+func (o *Orchestrator) mirrorRequest(ctx context.Context, req SubRequest, primary Response) {
+	shadowResults := o.mirror.ExecuteBatch(context.WithoutCancel(ctx), []SubRequest{req})
+	if len(shadowResults) != 1 || o.mirrorDiffs == nil {
+		return
+	}
+	shadow := shadowResults[0]
+	if !mirrorDiverged(primary, shadow) {
+		return
+	}
+	o.mirrorDiffs.OnMirrorDiff(MirrorDiff{
+		Request: o.redactRequest(req),
+		Primary: primary,
+		Shadow:  shadow,
+	})
+}
+
+// mirrorDiverged reports whether the shadow response differs from the
+// primary in status, error code, or data.
+func mirrorDiverged(primary, shadow Response) bool {
+	if primary.Status != shadow.Status {
+		return true
+	}
+	primaryCode, shadowCode := "", ""
+	if primary.Error != nil {
+		primaryCode = primary.Error.Code
+	}
+	if shadow.Error != nil {
+		shadowCode = shadow.Error.Code
+	}
+	if primaryCode != shadowCode {
+		return true
+	}
+	return !reflect.DeepEqual(primary.Data, shadow.Data)
+}
@@ -0,0 +1,116 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithMirror_ReplaysToSecondary(t *testing.T) {
+	secondary := NewMockExecutor()
+
+	orch := New(WithMirror(secondary, 100))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hi"},
+	})
+	if results[0].Status != 200 {
+		t.Fatalf("primary Status = %d, want 200", results[0].Status)
+	}
+
+	deadline := time.After(2 * time.Second)
+	for len(secondary.Calls()) == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("secondary never received the mirrored request")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	if calls := secondary.Calls(); calls[0].ID != "1" || calls[0].Payload != "hi" {
+		t.Errorf("mirrored request = %+v, want the original", calls[0])
+	}
+}
+
+func TestWithMirror_ReportsDiffs(t *testing.T) {
+	secondary := NewMockExecutor()
+	secondary.RespondToRecipe("echo", Response{Status: 500, Error: &Error{Code: ErrCodeRecipeExecution, Message: "candidate broken"}})
+
+	var mu sync.Mutex
+	var diffs []MirrorDiff
+	orch := New(
+		WithMirror(secondary, 100),
+		WithMirrorDiffHook(MirrorDiffHookFunc(func(diff MirrorDiff) {
+			mu.Lock()
+			diffs = append(diffs, diff)
+			mu.Unlock()
+		})),
+	)
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hi"},
+	})
+
+	deadline := time.After(2 * time.Second)
+	for {
+		mu.Lock()
+		n := len(diffs)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("no diff reported within 2s")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if diffs[0].Primary.Status != 200 || diffs[0].Shadow.Status != 500 {
+		t.Errorf("diff = primary %d / shadow %d, want 200/500", diffs[0].Primary.Status, diffs[0].Shadow.Status)
+	}
+}
+
+func TestWithMirror_ZeroPercentNeverMirrors(t *testing.T) {
+	secondary := NewMockExecutor()
+	orch := New(WithMirror(secondary, 0))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	for i := 0; i < 50; i++ {
+		orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "echo"},
+		})
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	if n := len(secondary.Calls()); n != 0 {
+		t.Errorf("secondary received %d requests, want 0 at 0%%", n)
+	}
+}
+
+func TestWithMirror_InvalidArgsPanic(t *testing.T) {
+	for name, build := range map[string]func(){
+		"nil secondary": func() { New(WithMirror(nil, 10)) },
+		"percent > 100": func() { New(WithMirror(NewMockExecutor(), 101)) },
+		"negative":      func() { New(WithMirror(NewMockExecutor(), -1)) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: WithMirror did not panic", name)
+				}
+			}()
+			build()
+		}()
+	}
+}
@@ -92,8 +92,118 @@ func WithMaxBatchSize(max int) Option {
 	}
 }
 
+// WithResponseTimestamps populates Response.StartedAt and
+// Response.CompletedAt for every executed request. They marshal as
+// RFC 3339 timestamps, useful for audit trails and latency debugging
+// across service boundaries.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithResponseTimestamps())
+func WithResponseTimestamps() Option {
+	return func(o *Orchestrator) {
+		o.timestamps = true
+	}
+}
+
+// WithTimeoutBudget divides the caller's remaining context deadline
+// evenly across a recipe's retry attempts, so a retried request never
+// exceeds the caller's budget: with a 300ms deadline and 2 retries,
+// each attempt gets roughly 100ms instead of the first attempt
+// consuming the whole window. Only applies to requests whose incoming
+// context carries a deadline; others use the configured timeouts
+// unchanged.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithTimeoutBudget())
+func WithTimeoutBudget() Option {
+	return func(o *Orchestrator) {
+		o.timeoutBudget = true
+	}
+}
+
+// WithHandlerSupervision runs every handler in a supervised goroutine.
+// Timeouts normally rely on handlers checking ctx cooperatively: a
+// handler that ignores ctx keeps its goroutine — and its concurrency
+// slot — until it returns. Under supervision the request is answered
+// (and the slot released) the moment the deadline fires, while the
+// runaway handler keeps running detached. Detached handlers are counted
+// by LeakedHandlers and their eventual results go to the hook installed
+// via WithLateResultHook.
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithTimeout(time.Second),
+//		relayer.WithHandlerSupervision(),
+//	)
+func WithHandlerSupervision() Option {
+	return func(o *Orchestrator) {
+		o.supervised = true
+	}
+}
+
+// PanicPolicy controls how a recipe's panics are handled after the
+// panic hook has run.
+type PanicPolicy int
+
+const (
+	// PanicFail returns a 500 immediately. This is the default.
+	PanicFail PanicPolicy = iota
+	// PanicRetry re-executes the recipe up to PanicRetries times,
+	// treating a single panic (e.g. a nil map from a race in a
+	// dependency) as transient. The panic hook fires for every attempt.
+	PanicRetry
+	// PanicFallbackPolicy runs the recipe's PanicFallback handler and
+	// returns its result instead of the 500. If the fallback itself
+	// fails, the original panic response stands.
+	PanicFallbackPolicy
+)
+
 // RecipeOption configures a specific recipe.
 // Allows per-recipe timeout overrides and other recipe-specific settings.
 type RecipeOption struct {
 	Timeout time.Duration // Override default timeout for this recipe
+	Budget  *Budget       // Override default resource budget for this recipe
+
+	// Retry policy for transient recipe errors. When MaxRetries > 0, a
+	// failed execution is retried with exponential backoff (Backoff,
+	// 2*Backoff, 4*Backoff, ...) before a 500 is returned. Timeouts are
+	// never retried; panics follow PanicPolicy. RetryOn, if set, filters
+	// which errors are retryable; nil retries every recipe error.
+	MaxRetries int
+	Backoff    time.Duration
+	RetryOn    func(error) bool
+
+	// DetachOnTimeout lets this recipe's handler finish in the background
+	// after its deadline fires: the request still gets its 504
+	// immediately, but the handler's eventual result is delivered to the
+	// hook installed via WithLateResultHook instead of being discarded.
+	// Useful for expensive work worth caching even when the caller gave
+	// up waiting.
+	DetachOnTimeout bool
+
+	// PanicPolicy controls what happens after this recipe panics (the
+	// panic hook fires on every panic regardless). PanicRetries caps
+	// retry attempts under PanicRetry (default 1); PanicFallback is the
+	// handler run under PanicFallbackPolicy.
+	PanicPolicy   PanicPolicy
+	PanicRetries  int
+	PanicFallback Handler
+
+	// Middleware applied around this recipe only, inside any global
+	// middleware configured via WithMiddleware.
+	Middleware []Middleware
+
+	// MaxConcurrency caps concurrent executions of this recipe only,
+	// independent of the orchestrator-wide WithMaxConcurrency limit.
+	// 0 means no per-recipe limit. Useful for throttling recipes bound
+	// to a slow backend without constraining overall parallelism.
+	MaxConcurrency int
+
+	// Description and Tags are informational metadata surfaced by
+	// ListRecipes, for admin UIs and self-documenting batch endpoints.
+	Description string
+	Tags        []string
 }
@@ -0,0 +1,123 @@
+package relayer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPanicPolicy_RetryRecoversTransientPanic(t *testing.T) {
+	var hookCalls atomic.Int64
+	orch := New(WithPanicHook(&countingPanicHook{calls: &hookCalls}))
+
+	var attempts atomic.Int64
+	orch.RegisterRecipe("flaky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if attempts.Add(1) == 1 {
+			panic("transient nil map")
+		}
+		return "recovered", nil
+	}, &RecipeOption{PanicPolicy: PanicRetry})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "flaky"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "recovered" {
+		t.Errorf("got (%d, %v), want (200, recovered) after one panic retry", results[0].Status, results[0].Data)
+	}
+	if got := hookCalls.Load(); got != 1 {
+		t.Errorf("panic hook fired %d times, want 1", got)
+	}
+}
+
+func TestPanicPolicy_RetryExhaustedReturns500(t *testing.T) {
+	var hookCalls atomic.Int64
+	orch := New(WithPanicHook(&countingPanicHook{calls: &hookCalls}))
+
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("always")
+	}, &RecipeOption{PanicPolicy: PanicRetry, PanicRetries: 2})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "broken"},
+	})
+
+	if results[0].Status != 500 || results[0].Error.Code != ErrCodePanic {
+		t.Errorf("got (%d, %v), want a 500 PANIC after exhausting retries", results[0].Status, results[0].Error)
+	}
+	// Initial attempt plus two retries, hook called each time.
+	if got := hookCalls.Load(); got != 3 {
+		t.Errorf("panic hook fired %d times, want 3", got)
+	}
+}
+
+func TestPanicPolicy_Fallback(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("fragile", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	}, &RecipeOption{
+		PanicPolicy: PanicFallbackPolicy,
+		PanicFallback: func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return "cached default", nil
+		},
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fragile"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "cached default" {
+		t.Errorf("got (%d, %v), want the fallback result", results[0].Status, results[0].Data)
+	}
+}
+
+func TestPanicPolicy_FallbackPanicKeepsOriginalError(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("fragile", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	}, &RecipeOption{
+		PanicPolicy: PanicFallbackPolicy,
+		PanicFallback: func(ctx context.Context, payload interface{}) (interface{}, error) {
+			panic("fallback kaboom")
+		},
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fragile"},
+	})
+
+	if results[0].Status != 500 || results[0].Error.Code != ErrCodePanic {
+		t.Errorf("got (%d, %v), want the original 500 PANIC", results[0].Status, results[0].Error)
+	}
+}
+
+func TestPanicPolicy_DefaultFailsImmediately(t *testing.T) {
+	var hookCalls atomic.Int64
+	orch := New(WithPanicHook(&countingPanicHook{calls: &hookCalls}))
+
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("always")
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "broken"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500", results[0].Status)
+	}
+	if got := hookCalls.Load(); got != 1 {
+		t.Errorf("panic hook fired %d times, want 1 (no retries by default)", got)
+	}
+}
+
+// countingPanicHook counts OnPanic invocations.
+type countingPanicHook struct {
+	calls *atomic.Int64
+}
+
+func (h *countingPanicHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	h.calls.Add(1)
+}
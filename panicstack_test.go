@@ -0,0 +1,88 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type stackCaptureHook struct {
+	mu        sync.Mutex
+	plain     int
+	recovered interface{}
+	stack     []byte
+}
+
+func (h *stackCaptureHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.plain++
+}
+
+func (h *stackCaptureHook) OnPanicWithStack(ctx context.Context, req SubRequest, recovered interface{}, stack []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.recovered = recovered
+	h.stack = stack
+}
+
+func TestPanicStackHook_ReceivesStack(t *testing.T) {
+	hook := &stackCaptureHook{}
+	orch := New(WithPanicHook(hook))
+	orch.RegisterRecipe("boom", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "boom"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500", results[0].Status)
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if hook.plain != 0 {
+		t.Errorf("OnPanic calls = %d, want 0 when OnPanicWithStack is implemented", hook.plain)
+	}
+	if hook.recovered != "kaboom" {
+		t.Errorf("recovered = %v, want kaboom", hook.recovered)
+	}
+	if len(hook.stack) == 0 {
+		t.Fatal("stack is empty, want a captured stack trace")
+	}
+	if !strings.Contains(string(hook.stack), "panic") {
+		t.Errorf("stack does not mention panic:\n%s", hook.stack)
+	}
+}
+
+type plainPanicHook struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (h *plainPanicHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+}
+
+func TestPanicStackHook_PlainHookStillWorks(t *testing.T) {
+	hook := &plainPanicHook{}
+	orch := New(WithPanicHook(hook))
+	orch.RegisterRecipe("boom", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "boom"},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if hook.calls != 1 {
+		t.Errorf("OnPanic calls = %d, want 1", hook.calls)
+	}
+}
@@ -0,0 +1,55 @@
+package relayer
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ErrCodePartialFailure marks a 206 response where some items of an
+// array payload failed while the rest succeeded.
+const ErrCodePartialFailure = "PARTIAL_FAILURE"
+
+// PartialResult is the Responder returned by Partial. Handlers return
+// it as their data to produce a 206 response carrying the successful
+// items alongside per-item error details.
+type PartialResult struct {
+	data       interface{}
+	itemErrors map[int]error
+}
+
+// Partial builds a 206 partial-success result for a handler processing
+// an array payload: data holds the successfully processed items and
+// itemErrors maps failed input indexes to their errors. With no item
+// errors the result degrades to a plain 200, so handlers can return
+// Partial unconditionally.
+//
+// Example:
+//
+//	orch.RegisterRecipe("import-rows", func(ctx context.Context, payload interface{}) (interface{}, error) {
+//		rows := payload.([]interface{})
+//		imported, itemErrors := importRows(rows)
+//		return relayer.Partial(imported, itemErrors), nil
+//	})
+func Partial(data interface{}, itemErrors map[int]error) *PartialResult {
+	return &PartialResult{data: data, itemErrors: itemErrors}
+}
+
+// Response implements Responder.
+func (p *PartialResult) Response() Response {
+	if len(p.itemErrors) == 0 {
+		return Response{Status: 200, Data: p.data}
+	}
+	items := make(map[string]interface{}, len(p.itemErrors))
+	for index, err := range p.itemErrors {
+		items[strconv.Itoa(index)] = err.Error()
+	}
+	return Response{
+		Status: 206,
+		Data:   p.data,
+		Error: &Error{
+			Code:    ErrCodePartialFailure,
+			Message: fmt.Sprintf("%d items failed", len(p.itemErrors)),
+			Details: map[string]interface{}{"item_errors": items},
+		},
+	}
+}
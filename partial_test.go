@@ -0,0 +1,65 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPartial_SetsStatus206WithItemErrors(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("import", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return Partial([]string{"row-0", "row-2"}, map[int]error{
+			1: errors.New("bad row"),
+		}), nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "import"},
+	})
+
+	resp := results[0]
+	if resp.Status != 206 {
+		t.Errorf("Status = %d, want 206", resp.Status)
+	}
+	data, _ := resp.Data.([]string)
+	if len(data) != 2 {
+		t.Errorf("Data = %v, want the 2 successful rows", resp.Data)
+	}
+	if resp.Error == nil || resp.Error.Code != ErrCodePartialFailure {
+		t.Fatalf("Error = %v, want code %s", resp.Error, ErrCodePartialFailure)
+	}
+	items, _ := resp.Error.Details["item_errors"].(map[string]interface{})
+	if items["1"] != "bad row" {
+		t.Errorf("item_errors = %v, want index 1 -> bad row", items)
+	}
+}
+
+func TestPartial_NoErrorsDegradesTo200(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("import", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return Partial([]string{"row-0"}, nil), nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "import"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+	if results[0].Error != nil {
+		t.Errorf("Error = %v, want nil", results[0].Error)
+	}
+}
+
+func TestPartial_CountsAsSuccessForFilters(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 206},
+		{ID: "2", Status: 500},
+	}
+	successes := FilterSuccess(responses)
+	if len(successes) != 1 || successes[0].ID != "1" {
+		t.Errorf("FilterSuccess = %v, want the 206 response", successes)
+	}
+}
@@ -0,0 +1,66 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+)
+
+// RegisterPipeline registers a recipe that chains existing recipes: each
+// stage receives the previous stage's output as its payload, and the
+// final stage's output becomes the pipeline result. Stages are resolved
+// at execution time, so they may be registered in any order (and swapped
+// later), but a missing stage fails the request with a stage-qualified
+// error.
+//
+// All stages run within the pipeline's single timeout and context, so a
+// pipeline behaves like one recipe to callers, hooks, and limits.
+//
+// Example:
+//
+//	orch.RegisterPipeline("enrich-user", []string{"get-user", "get-permissions", "merge"})
+//
+// Panics if the name is empty or no stages are given, matching
+// RegisterRecipe's validation behavior.
+func (o *Orchestrator) RegisterPipeline(name string, stages []string, opts ...*RecipeOption) {
+	if name == "" {
+		panic("pipeline name cannot be empty")
+	}
+	if len(stages) == 0 {
+		panic("pipeline must have at least one stage")
+	}
+	for _, stage := range stages {
+		if stage == "" {
+			panic("pipeline stage name cannot be empty")
+		}
+	}
+
+	// Copy so later mutation of the caller's slice can't change the pipeline.
+	chain := make([]string, len(stages))
+	copy(chain, stages)
+
+	o.RegisterRecipe(name, func(ctx context.Context, payload interface{}) (interface{}, error) {
+		current := payload
+		for _, stage := range chain {
+			o.mu.RLock()
+			handler, exists := o.registry[stage]
+			o.mu.RUnlock()
+
+			if !exists {
+				return nil, fmt.Errorf("pipeline '%s' stage '%s': recipe not found", name, stage)
+			}
+
+			result, err := handler(ctx, current)
+			if err != nil {
+				return nil, fmt.Errorf("pipeline '%s' stage '%s': %w", name, stage, err)
+			}
+
+			// Stop early if the pipeline's context expired mid-chain.
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			current = result
+		}
+		return current, nil
+	}, opts...)
+}
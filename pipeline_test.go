@@ -0,0 +1,93 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRegisterPipeline_ChainsStages(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("double", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) * 2, nil
+	})
+	orch.RegisterRecipe("add-one", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) + 1, nil
+	})
+
+	orch.RegisterPipeline("double-then-add", []string{"double", "add-one"})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "double-then-add", Payload: 5},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200 (error: %v)", results[0].Status, results[0].Error)
+	}
+
+	if results[0].Data != 11 {
+		t.Errorf("Data = %v, want 11 (5*2+1)", results[0].Data)
+	}
+}
+
+func TestRegisterPipeline_StageErrorIsQualified(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("stage failure")
+	})
+
+	orch.RegisterPipeline("p", []string{"ok", "broken", "ok"})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "p"},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500", results[0].Status)
+	}
+
+	if !strings.Contains(results[0].Error.Message, "stage 'broken'") {
+		t.Errorf("Error message = %q, want stage qualification", results[0].Error.Message)
+	}
+}
+
+func TestRegisterPipeline_MissingStage(t *testing.T) {
+	orch := New()
+
+	orch.RegisterPipeline("p", []string{"ghost"})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "p"},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500", results[0].Status)
+	}
+
+	if !strings.Contains(results[0].Error.Message, "recipe not found") {
+		t.Errorf("Error message = %q, want missing-stage error", results[0].Error.Message)
+	}
+}
+
+func TestRegisterPipeline_Validation(t *testing.T) {
+	orch := New()
+
+	assertPanics := func(name string, fn func()) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s: expected panic", name)
+			}
+		}()
+		fn()
+	}
+
+	assertPanics("empty name", func() { orch.RegisterPipeline("", []string{"a"}) })
+	assertPanics("no stages", func() { orch.RegisterPipeline("p", nil) })
+	assertPanics("empty stage", func() { orch.RegisterPipeline("p", []string{""}) })
+}
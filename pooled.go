@@ -0,0 +1,99 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+)
+
+// responsePool recycles Response slices for ExecuteBatchInto callers
+// that don't supply a large enough buffer. Slices are pooled by
+// whatever capacity they were released with; GetResponses grows the
+// pooled slice when it's too small.
+var responsePool = sync.Pool{
+	New: func() interface{} {
+		s := make([]Response, 0, 64)
+		return &s
+	},
+}
+
+// GetResponses returns a zeroed Response slice of length n from the
+// internal pool. Pair with PutResponses once the caller is done with the
+// results to reduce GC pressure under high batch throughput.
+func GetResponses(n int) []Response {
+	sp := responsePool.Get().(*[]Response)
+	s := *sp
+	if cap(s) < n {
+		s = make([]Response, n)
+	} else {
+		s = s[:n]
+		clear(s)
+	}
+	return s
+}
+
+// PutResponses returns a slice obtained from GetResponses to the pool.
+// The caller must not use the slice afterwards. Responses holding Data
+// references are zeroed so pooled memory doesn't pin handler results.
+func PutResponses(s []Response) {
+	if s == nil {
+		return
+	}
+	clear(s)
+	s = s[:0]
+	responsePool.Put(&s)
+}
+
+// ExecuteBatchInto executes a batch like ExecuteBatch but writes the
+// responses into the caller-provided slice, growing it only when its
+// capacity is insufficient. Combined with GetResponses/PutResponses this
+// lets high-throughput callers recycle result buffers across batches
+// instead of allocating a fresh slice per call.
+//
+// The returned slice aliases results when it had sufficient capacity.
+// Responses are in batch order, exactly as ExecuteBatch would return
+// them.
+//
+// Example:
+//
+//	results := relayer.GetResponses(len(batch))
+//	results = orch.ExecuteBatchInto(ctx, batch, results)
+//	consume(results)
+//	relayer.PutResponses(results)
+func (o *Orchestrator) ExecuteBatchInto(ctx context.Context, batch []SubRequest, results []Response) []Response {
+	if cap(results) < len(batch) {
+		results = make([]Response, len(batch))
+	} else {
+		results = results[:len(batch)]
+		clear(results)
+	}
+
+	if rejected, ok := o.admitBatch(batch); !ok {
+		copy(results, rejected)
+		return results
+	}
+
+	ctx = ensureBatchID(ctx)
+
+	// Reject duplicate request IDs when configured, same as
+	// ExecuteBatch; reuse the regular path and copy into the caller's
+	// buffer.
+	if o.rejectDuplicateIDs && len(batch) > 1 {
+		copy(results, o.executeBatchRejectDups(ctx, batch))
+		return results
+	}
+
+	// Deduplicated batches build their own fan-out structures; reuse the
+	// regular path and copy into the caller's buffer.
+	if o.dedupKey != nil && len(batch) > 1 {
+		copy(results, o.executeBatchDeduped(ctx, batch))
+		return results
+	}
+
+	if len(batch) == 1 {
+		o.processRequest(ctx, batch[0], &results[0])
+		return results
+	}
+
+	o.dispatch(ctx, batch, results)
+	return results
+}
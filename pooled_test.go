@@ -0,0 +1,124 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExecuteBatchInto_ReusesCallerSlice(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	buf := make([]Response, 0, 8)
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "a"},
+		{ID: "2", TenantID: "t", Recipe: "echo", Payload: "b"},
+	}
+
+	results := orch.ExecuteBatchInto(context.Background(), batch, buf)
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if &results[0] != &buf[:1][0] {
+		t.Error("results should alias the caller-provided buffer when capacity suffices")
+	}
+	if results[0].Data != "a" || results[1].Data != "b" {
+		t.Errorf("results = %v, want echoed payloads in batch order", results)
+	}
+}
+
+func TestExecuteBatchInto_GrowsWhenTooSmall(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+		{ID: "2", TenantID: "t", Recipe: "echo"},
+		{ID: "3", TenantID: "t", Recipe: "echo"},
+	}
+
+	results := orch.ExecuteBatchInto(context.Background(), batch, nil)
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Errorf("results[%d].Status = %d, want 200", i, resp.Status)
+		}
+	}
+}
+
+func TestExecuteBatchInto_ClearsStaleResults(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("missing-check", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	buf := make([]Response, 4)
+	for i := range buf {
+		buf[i] = Response{ID: "stale", Status: 999, Data: "leftover"}
+	}
+
+	results := orch.ExecuteBatchInto(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "missing-check"},
+	}, buf)
+
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if results[0].ID != "1" || results[0].Data == "leftover" {
+		t.Errorf("results[0] = %+v, want stale data cleared", results[0])
+	}
+}
+
+func TestGetPutResponses(t *testing.T) {
+	s := GetResponses(5)
+	if len(s) != 5 {
+		t.Fatalf("GetResponses(5) length = %d, want 5", len(s))
+	}
+	for i := range s {
+		if s[i].ID != "" || s[i].Status != 0 {
+			t.Errorf("s[%d] = %+v, want zero value", i, s[i])
+		}
+	}
+
+	s[0] = Response{ID: "x", Status: 200, Data: "payload"}
+	PutResponses(s)
+
+	again := GetResponses(3)
+	for i := range again {
+		if again[i].ID != "" || again[i].Data != nil {
+			t.Errorf("recycled slice entry %d = %+v, want zeroed", i, again[i])
+		}
+	}
+	PutResponses(again)
+}
+
+func TestExecuteBatchInto_RejectedBatch(t *testing.T) {
+	orch := New(WithMaxBatchSize(1))
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "any"},
+		{ID: "2", TenantID: "t", Recipe: "any"},
+	}
+
+	results := orch.ExecuteBatchInto(context.Background(), batch, make([]Response, 0, 4))
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for i, resp := range results {
+		if resp.Status != 413 {
+			t.Errorf("results[%d].Status = %d, want 413", i, resp.Status)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package relayer
+
+import (
+	"context"
+	"runtime/pprof"
+)
+
+// WithPprofLabels runs every handler under pprof labels carrying the
+// tenant, recipe, and request ID, so CPU and goroutine profiles taken
+// in production attribute samples to specific recipes and tenants.
+// Labels apply for the duration of the handler call, including detached
+// execution under WithHandlerSupervision.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithPprofLabels())
+//	// go tool pprof then groups samples by tenant/recipe/request_id.
+func WithPprofLabels() Option {
+	return func(o *Orchestrator) {
+		o.pprofLabels = true
+	}
+}
+
+// withPprofLabels wraps a handler so it executes under request-scoped
+// pprof labels.
+func withPprofLabels(req SubRequest, handler Handler) Handler {
+	labels := pprof.Labels(
+		"tenant", req.TenantID,
+		"recipe", req.Recipe,
+		"request_id", req.ID,
+	)
+	return func(ctx context.Context, payload interface{}) (data interface{}, err error) {
+		pprof.Do(ctx, labels, func(ctx context.Context) {
+			data, err = handler(ctx, payload)
+		})
+		return data, err
+	}
+}
@@ -0,0 +1,51 @@
+package relayer
+
+import (
+	"context"
+	"runtime/pprof"
+	"testing"
+)
+
+func TestWithPprofLabels_HandlerSeesRequestLabels(t *testing.T) {
+	orch := New(WithPprofLabels())
+
+	var got map[string]string
+	orch.RegisterRecipe("profiled", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		got = make(map[string]string)
+		for _, key := range []string{"tenant", "recipe", "request_id"} {
+			if value, ok := pprof.Label(ctx, key); ok {
+				got[key] = value
+			}
+		}
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "req-9", TenantID: "acme", Recipe: "profiled"},
+	})
+
+	want := map[string]string{"tenant": "acme", "recipe": "profiled", "request_id": "req-9"}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("label %s = %q, want %q", key, got[key], value)
+		}
+	}
+}
+
+func TestWithoutPprofLabels_NoLabelsApplied(t *testing.T) {
+	orch := New()
+
+	var labeled bool
+	orch.RegisterRecipe("plain", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		_, labeled = pprof.Label(ctx, "recipe")
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "plain"},
+	})
+
+	if labeled {
+		t.Error("handler saw pprof labels without WithPprofLabels")
+	}
+}
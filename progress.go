@@ -0,0 +1,182 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchProgress is a point-in-time snapshot of an asynchronously
+// submitted batch: how many requests have finished and an estimate of
+// the time remaining, derived from the batch's observed throughput so
+// far. ETA is zero until the first request completes.
+type BatchProgress struct {
+	BatchID   string
+	Total     int
+	Completed int
+	Failed    int // completed requests with a non-2xx status
+	ETA       time.Duration
+}
+
+// ProgressHook receives a progress snapshot each time a request in an
+// asynchronously submitted batch completes, so UIs can render progress
+// bars without polling.
+type ProgressHook interface {
+	OnProgress(p BatchProgress)
+}
+
+// WithProgressHook installs a hook invoked after every request
+// completion within batches submitted via SubmitBatch. Panics if hook
+// is nil.
+func WithProgressHook(hook ProgressHook) Option {
+	return func(o *Orchestrator) {
+		if hook == nil {
+			panic("progress hook cannot be nil")
+		}
+		o.progressHook = hook
+	}
+}
+
+// progressEntry tracks completion counts for one running batch.
+type progressEntry struct {
+	mu        sync.Mutex
+	batchID   string
+	total     int
+	completed int
+	failed    int
+	startedAt time.Time
+}
+
+// complete records one finished request and returns the updated snapshot.
+func (e *progressEntry) complete(status int, now time.Time) BatchProgress {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.completed++
+	if status < 200 || status >= 300 {
+		e.failed++
+	}
+	return e.snapshotLocked(now)
+}
+
+func (e *progressEntry) snapshot(now time.Time) BatchProgress {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.snapshotLocked(now)
+}
+
+func (e *progressEntry) snapshotLocked(now time.Time) BatchProgress {
+	p := BatchProgress{
+		BatchID:   e.batchID,
+		Total:     e.total,
+		Completed: e.completed,
+		Failed:    e.failed,
+	}
+	// Estimate remaining time from the batch's throughput so far:
+	// elapsed wall time per completion, times the requests left.
+	if remaining := e.total - e.completed; remaining > 0 && e.completed > 0 {
+		elapsed := now.Sub(e.startedAt)
+		p.ETA = time.Duration(int64(elapsed) / int64(e.completed) * int64(remaining))
+	}
+	return p
+}
+
+// progressRegistry holds progress entries for batches currently running.
+type progressRegistry struct {
+	mu     sync.RWMutex
+	active map[string]*progressEntry
+}
+
+func newProgressRegistry() *progressRegistry {
+	return &progressRegistry{active: make(map[string]*progressEntry)}
+}
+
+func (r *progressRegistry) begin(batchID string, total int, now time.Time) *progressEntry {
+	entry := &progressEntry{batchID: batchID, total: total, startedAt: now}
+	r.mu.Lock()
+	r.active[batchID] = entry
+	r.mu.Unlock()
+	return entry
+}
+
+func (r *progressRegistry) end(batchID string) {
+	r.mu.Lock()
+	delete(r.active, batchID)
+	r.mu.Unlock()
+}
+
+func (r *progressRegistry) get(batchID string) (*progressEntry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, ok := r.active[batchID]
+	return entry, ok
+}
+
+// GetProgress returns the live progress of a batch submitted via
+// SubmitBatch. The second return is false once the batch has finished
+// (or was never submitted); completed batches are available through
+// GetJob.
+func (o *Orchestrator) GetProgress(batchID string) (BatchProgress, bool) {
+	entry, ok := o.progress.get(batchID)
+	if !ok {
+		return BatchProgress{}, false
+	}
+	return entry.snapshot(o.clock.Now()), true
+}
+
+// executeJobTracked mirrors ExecuteBatch for asynchronously submitted
+// jobs: it records per-request completions against the job's progress
+// entry, notifies the progress hook, and checkpoints each result to the
+// job store so an interrupted job can resume where it left off.
+// Requests whose ID already appears in the job's checkpoint are not
+// re-executed; their stored responses are reused.
+func (o *Orchestrator) executeJobTracked(ctx context.Context, job *Job) []Response {
+	batch := job.Requests
+	if rejected, ok := o.admitBatch(batch); !ok {
+		return rejected
+	}
+
+	// Async jobs already have an ID; sub-requests inherit it.
+	ctx = WithBatchID(ctx, job.BatchID)
+
+	// Snapshot the checkpoint before scheduling: workers mutate it
+	// concurrently as fresh requests complete.
+	done := make(map[string]Response, len(job.Checkpoint))
+	for id, resp := range job.Checkpoint {
+		done[id] = resp
+	}
+
+	entry := o.progress.begin(job.BatchID, len(batch), o.clock.Now())
+	defer o.progress.end(job.BatchID)
+
+	results := make([]Response, len(batch))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, req := range batch {
+		if resp, ok := done[req.ID]; ok {
+			results[i] = resp
+			entry.complete(resp.Status, o.clock.Now())
+			continue
+		}
+		wg.Add(1)
+		i, req := i, req
+		o.scheduler.Schedule(func() {
+			defer wg.Done()
+			o.processRequest(ctx, req, &results[i])
+			p := entry.complete(results[i].Status, o.clock.Now())
+
+			mu.Lock()
+			if job.Checkpoint == nil {
+				job.Checkpoint = make(map[string]Response)
+			}
+			job.Checkpoint[req.ID] = results[i]
+			o.jobStore.UpdateJob(job)
+			mu.Unlock()
+
+			if o.progressHook != nil {
+				o.progressHook.OnProgress(p)
+			}
+		})
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,123 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingProgressHook collects every progress snapshot it receives.
+type recordingProgressHook struct {
+	mu        sync.Mutex
+	snapshots []BatchProgress
+}
+
+func (h *recordingProgressHook) OnProgress(p BatchProgress) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.snapshots = append(h.snapshots, p)
+}
+
+func (h *recordingProgressHook) all() []BatchProgress {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]BatchProgress(nil), h.snapshots...)
+}
+
+func TestProgressHook_ReportsEveryCompletion(t *testing.T) {
+	hook := &recordingProgressHook{}
+	orch := New(
+		WithJobStore(NewMemoryJobStore()),
+		WithProgressHook(hook),
+	)
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, context.DeadlineExceeded
+	})
+
+	batchID, err := orch.SubmitBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+		{ID: "2", TenantID: "t", Recipe: "ok"},
+		{ID: "3", TenantID: "t", Recipe: "bad"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitBatch() error = %v", err)
+	}
+
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+
+	snapshots := hook.all()
+	if len(snapshots) != 3 {
+		t.Fatalf("got %d progress snapshots, want 3", len(snapshots))
+	}
+	last := snapshots[len(snapshots)-1]
+	if last.Completed != 3 || last.Total != 3 {
+		t.Errorf("final progress = %d/%d, want 3/3", last.Completed, last.Total)
+	}
+	if last.Failed != 1 {
+		t.Errorf("Failed = %d, want 1", last.Failed)
+	}
+	if last.BatchID != batchID {
+		t.Errorf("BatchID = %q, want %q", last.BatchID, batchID)
+	}
+}
+
+func TestGetProgress_WhileRunning(t *testing.T) {
+	release := make(chan struct{})
+	orch := New(WithJobStore(NewMemoryJobStore()))
+	orch.RegisterRecipe("gate", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if payload == "block" {
+			<-release
+		}
+		return nil, nil
+	})
+
+	batchID, err := orch.SubmitBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "gate", Payload: "go"},
+		{ID: "2", TenantID: "t", Recipe: "gate", Payload: "block"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitBatch() error = %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	var p BatchProgress
+	for time.Now().Before(deadline) {
+		var ok bool
+		if p, ok = orch.GetProgress(batchID); ok && p.Completed == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if p.Completed != 1 || p.Total != 2 {
+		t.Fatalf("progress = %d/%d, want 1/2", p.Completed, p.Total)
+	}
+	if p.ETA <= 0 {
+		t.Errorf("ETA = %v, want > 0 while work remains", p.ETA)
+	}
+
+	close(release)
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+
+	if _, ok := orch.GetProgress(batchID); ok {
+		t.Error("GetProgress should report no live entry after completion")
+	}
+}
+
+// waitForJobStatus polls until the job reaches the wanted status or the
+// test deadline passes.
+func waitForJobStatus(t *testing.T, orch *Orchestrator, batchID string, want JobStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := orch.GetJob(batchID)
+		if err == nil && job.Status == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("job %s never reached status %v", batchID, want)
+}
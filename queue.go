@@ -0,0 +1,228 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Queue-mode errors.
+var (
+	// ErrQueueFull is returned by Enqueue when the queue is at capacity.
+	ErrQueueFull = errors.New("work queue is full")
+	// ErrQueueStopped is returned by Enqueue before Start or after Stop.
+	ErrQueueStopped = errors.New("work queue is not running")
+)
+
+// Queue decouples batch acceptance from execution: Enqueue appends to a
+// bounded in-memory queue and returns immediately, while background
+// workers drain it through the orchestrator. Useful for ingestion
+// services that must accept quickly and execute at their own pace.
+//
+// Example:
+//
+//	q := relayer.NewQueue(orch, 1024, 4)
+//	q.Start()
+//	defer q.Stop(ctx)
+//	...
+//	if err := q.Enqueue(batch); errors.Is(err, relayer.ErrQueueFull) {
+//		// shed or retry
+//	}
+type Queue struct {
+	orch     *Orchestrator
+	capacity int
+	workers  int
+	store    QueueStore // Durable backing (nil = in-memory only)
+
+	mu      sync.Mutex
+	ch      chan queuedWork
+	running bool
+	wg      sync.WaitGroup
+}
+
+// queuedWork is one queue entry: the batch plus its idempotency key
+// when a durable store is attached.
+type queuedWork struct {
+	key   string
+	batch []SubRequest
+}
+
+// QueueOption configures a Queue at construction.
+type QueueOption func(*Queue)
+
+// WithQueueStore attaches a durable store to the queue. Enqueued batches
+// are persisted before acceptance and marked complete after execution;
+// Start re-enqueues batches that were pending when the process last
+// stopped. Panics if store is nil.
+func WithQueueStore(store QueueStore) QueueOption {
+	return func(q *Queue) {
+		if store == nil {
+			panic("queue store cannot be nil")
+		}
+		q.store = store
+	}
+}
+
+// NewQueue creates a queue holding up to capacity batches, drained by
+// the given number of worker goroutines once started. Panics if capacity
+// or workers is not positive.
+func NewQueue(o *Orchestrator, capacity, workers int, opts ...QueueOption) *Queue {
+	if capacity <= 0 {
+		panic("queue capacity must be positive")
+	}
+	if workers <= 0 {
+		panic("queue workers must be positive")
+	}
+	q := &Queue{orch: o, capacity: capacity, workers: workers}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q
+}
+
+// Start launches the worker goroutines. With a durable store attached,
+// batches left pending by a previous run are re-enqueued first, oldest
+// first. Calling Start on a running queue is a no-op.
+func (q *Queue) Start() error {
+	q.mu.Lock()
+	if q.running {
+		q.mu.Unlock()
+		return nil
+	}
+	q.ch = make(chan queuedWork, q.capacity)
+	q.running = true
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.work(q.ch)
+	}
+	ch := q.ch
+	q.mu.Unlock()
+
+	if q.store != nil {
+		pending, err := q.store.Pending()
+		if err != nil {
+			return err
+		}
+		// Feed recovered batches from a goroutine: there may be more of
+		// them than the queue has capacity, and the workers are already
+		// draining. Each send re-checks that this incarnation of the
+		// queue is still running so Stop can't race a send onto a
+		// closed channel.
+		go func() {
+			for _, b := range pending {
+				if !q.offer(ch, queuedWork{key: b.Key, batch: b.Requests}) {
+					return
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Stop closes the queue to new batches and waits for the workers to
+// drain what was already enqueued, or for ctx to expire, whichever comes
+// first. After Stop the queue can be started again.
+func (q *Queue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.running {
+		q.mu.Unlock()
+		return nil
+	}
+	q.running = false
+	close(q.ch)
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// offer attempts to place work on ch, retrying while the queue is full,
+// until it succeeds or this incarnation of the queue stops. Returns
+// false if the queue stopped first.
+func (q *Queue) offer(ch chan queuedWork, work queuedWork) bool {
+	for {
+		q.mu.Lock()
+		if !q.running || q.ch != ch {
+			q.mu.Unlock()
+			return false
+		}
+		select {
+		case ch <- work:
+			q.mu.Unlock()
+			return true
+		default:
+			q.mu.Unlock()
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+// Enqueue appends a batch without blocking. Returns ErrQueueFull when
+// the queue is at capacity and ErrQueueStopped when the queue is not
+// running. With a durable store attached the batch is persisted under a
+// generated idempotency key before acceptance.
+func (q *Queue) Enqueue(batch []SubRequest) error {
+	return q.EnqueueKeyed(newBatchID(), batch)
+}
+
+// EnqueueKeyed is Enqueue with a caller-supplied idempotency key. With a
+// durable store attached, a key that was already enqueued or executed is
+// refused with ErrDuplicateBatch, so producers can safely retry after
+// crashes without double-executing.
+func (q *Queue) EnqueueKeyed(key string, batch []SubRequest) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.running {
+		return ErrQueueStopped
+	}
+	// All sends happen under q.mu and workers only drain, so this
+	// capacity check cannot go stale before the send below.
+	if len(q.ch) == cap(q.ch) {
+		return ErrQueueFull
+	}
+	if q.store != nil {
+		if err := q.store.Append(&QueuedBatch{
+			Key:        key,
+			Requests:   batch,
+			EnqueuedAt: time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+	q.ch <- queuedWork{key: key, batch: batch}
+	return nil
+}
+
+// Depth returns the number of batches waiting to be executed.
+func (q *Queue) Depth() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.running {
+		return 0
+	}
+	return len(q.ch)
+}
+
+func (q *Queue) work(ch <-chan queuedWork) {
+	defer q.wg.Done()
+	for work := range ch {
+		q.orch.ExecuteBatch(context.Background(), work.batch)
+		if q.store != nil {
+			q.store.Complete(work.key)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQueue_ProcessesEnqueuedBatches(t *testing.T) {
+	orch := New()
+	var executed atomic.Int64
+	orch.RegisterRecipe("count", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed.Add(1)
+		return nil, nil
+	})
+
+	q := NewQueue(orch, 10, 2)
+	q.Start()
+
+	for i := 0; i < 3; i++ {
+		if err := q.Enqueue([]SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "count"},
+			{ID: "2", TenantID: "t", Recipe: "count"},
+		}); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := q.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	if got := executed.Load(); got != 6 {
+		t.Errorf("executed %d requests, want 6", got)
+	}
+}
+
+func TestQueue_FullReturnsError(t *testing.T) {
+	orch := New()
+	release := make(chan struct{})
+	started := make(chan struct{}, 10)
+	orch.RegisterRecipe("block", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	})
+	defer close(release)
+
+	q := NewQueue(orch, 1, 1)
+	q.Start()
+
+	// First batch occupies the worker; second fills the queue.
+	q.Enqueue([]SubRequest{{ID: "1", TenantID: "t", Recipe: "block"}})
+	<-started
+	if err := q.Enqueue([]SubRequest{{ID: "2", TenantID: "t", Recipe: "block"}}); err != nil {
+		t.Fatalf("Enqueue() to fill queue: error = %v", err)
+	}
+
+	if err := q.Enqueue([]SubRequest{{ID: "3", TenantID: "t", Recipe: "block"}}); !errors.Is(err, ErrQueueFull) {
+		t.Errorf("Enqueue() on full queue: error = %v, want ErrQueueFull", err)
+	}
+	if q.Depth() != 1 {
+		t.Errorf("Depth() = %d, want 1", q.Depth())
+	}
+}
+
+func TestQueue_EnqueueBeforeStartAndAfterStop(t *testing.T) {
+	orch := New()
+	q := NewQueue(orch, 1, 1)
+
+	if err := q.Enqueue([]SubRequest{{ID: "1", TenantID: "t", Recipe: "x"}}); !errors.Is(err, ErrQueueStopped) {
+		t.Errorf("Enqueue() before Start: error = %v, want ErrQueueStopped", err)
+	}
+
+	q.Start()
+	if err := q.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	if err := q.Enqueue([]SubRequest{{ID: "1", TenantID: "t", Recipe: "x"}}); !errors.Is(err, ErrQueueStopped) {
+		t.Errorf("Enqueue() after Stop: error = %v, want ErrQueueStopped", err)
+	}
+}
@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueDuration_SeparatedFromExecution(t *testing.T) {
+	orch := New(WithMaxConcurrency(1))
+
+	orch.RegisterRecipe("sleep", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(30 * time.Millisecond)
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "sleep"},
+		{ID: "2", TenantID: "t", Recipe: "sleep"},
+	})
+
+	// One request ran immediately; the other queued behind it for
+	// roughly one execution's worth of time.
+	var queued *Response
+	for i := range results {
+		if results[i].QueueDuration >= 20*time.Millisecond {
+			queued = &results[i]
+		}
+	}
+	if queued == nil {
+		t.Fatalf("no response recorded a queue wait: %+v", results)
+	}
+
+	// Execution time must not absorb the queue wait.
+	if queued.Duration >= 50*time.Millisecond {
+		t.Errorf("Duration = %v, should exclude the ~30ms queue wait", queued.Duration)
+	}
+}
+
+func TestQueueDuration_ZeroWithoutConcurrencyLimit(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].QueueDuration != 0 {
+		t.Errorf("QueueDuration = %v, want 0", results[0].QueueDuration)
+	}
+}
+
+func TestQueueDuration_VisibleToOnComplete(t *testing.T) {
+	var mu sync.Mutex
+	var maxQueued time.Duration
+	hook := &queueDurationHook{onComplete: func(resp Response) {
+		mu.Lock()
+		if resp.QueueDuration > maxQueued {
+			maxQueued = resp.QueueDuration
+		}
+		mu.Unlock()
+	}}
+
+	orch := New(WithMaxConcurrency(1), WithExecutionHook(hook))
+	orch.RegisterRecipe("sleep", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(30 * time.Millisecond)
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "sleep"},
+		{ID: "2", TenantID: "t", Recipe: "sleep"},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxQueued < 20*time.Millisecond {
+		t.Errorf("OnComplete saw max QueueDuration %v, want a ~30ms wait", maxQueued)
+	}
+}
+
+// queueDurationHook forwards completions to a function for assertions.
+type queueDurationHook struct {
+	onComplete func(resp Response)
+}
+
+func (h *queueDurationHook) OnStart(ctx context.Context, req SubRequest) {}
+
+func (h *queueDurationHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	h.onComplete(resp)
+}
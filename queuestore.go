@@ -0,0 +1,183 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDuplicateBatch is returned when a batch is enqueued under an
+// idempotency key that was already enqueued or already executed.
+var ErrDuplicateBatch = errors.New("batch with this idempotency key already enqueued or executed")
+
+// QueuedBatch is a batch persisted by a QueueStore while it waits for
+// execution.
+type QueuedBatch struct {
+	// Key is the batch's idempotency key. A key is executed at most
+	// once per store, protecting against double execution when crashed
+	// processes re-enqueue on startup.
+	Key        string       `json:"key"`
+	Requests   []SubRequest `json:"requests"`
+	EnqueuedAt time.Time    `json:"enqueued_at"`
+}
+
+// QueueStore persists enqueued batches so queue mode survives crashes.
+// Implementations must be safe for concurrent use. The library ships a
+// durable file-backed store; external stores (BoltDB, SQLite, ...) can
+// implement the same interface.
+type QueueStore interface {
+	// Append persists a batch awaiting execution. Returns
+	// ErrDuplicateBatch if the key is pending or was already completed.
+	Append(batch *QueuedBatch) error
+
+	// Complete marks the batch with the given key as executed and
+	// removes it from the pending set.
+	Complete(key string) error
+
+	// Pending returns persisted batches that have not completed, oldest
+	// first. Called on queue startup to resume interrupted work.
+	Pending() ([]*QueuedBatch, error)
+}
+
+// FileQueueStore is a durable QueueStore writing one JSON file per
+// pending batch plus a journal of completed keys, both under a
+// directory. Suitable for single-process deployments; heavier stores
+// should implement QueueStore directly.
+type FileQueueStore struct {
+	mu        sync.Mutex
+	dir       string
+	completed map[string]bool
+}
+
+// NewFileQueueStore opens (creating if needed) a file-backed queue store
+// rooted at dir.
+func NewFileQueueStore(dir string) (*FileQueueStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating queue store directory: %w", err)
+	}
+
+	s := &FileQueueStore{dir: dir, completed: make(map[string]bool)}
+
+	// Reload the completed-key journal so keys stay idempotent across
+	// restarts.
+	data, err := os.ReadFile(s.journalPath())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading completed-key journal: %w", err)
+	}
+	for _, key := range strings.Fields(string(data)) {
+		s.completed[key] = true
+	}
+
+	return s, nil
+}
+
+func (s *FileQueueStore) journalPath() string {
+	return filepath.Join(s.dir, "completed.log")
+}
+
+func (s *FileQueueStore) batchPath(key string) string {
+	return filepath.Join(s.dir, key+".batch.json")
+}
+
+// Append persists the batch, refusing keys that are pending or already
+// completed.
+func (s *FileQueueStore) Append(batch *QueuedBatch) error {
+	if batch.Key == "" {
+		return errors.New("queued batch needs an idempotency key")
+	}
+	if strings.ContainsAny(batch.Key, `/\`) {
+		return fmt.Errorf("invalid idempotency key %q", batch.Key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.completed[batch.Key] {
+		return ErrDuplicateBatch
+	}
+	path := s.batchPath(batch.Key)
+	if _, err := os.Stat(path); err == nil {
+		return ErrDuplicateBatch
+	}
+
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("marshalling batch: %w", err)
+	}
+
+	// Write-and-rename so a crash mid-write never leaves a torn file.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing batch: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Complete records the key in the journal and removes the pending file.
+func (s *FileQueueStore) Complete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.completed[key] {
+		return nil
+	}
+
+	f, err := os.OpenFile(s.journalPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening completed-key journal: %w", err)
+	}
+	if _, err := fmt.Fprintln(f, key); err != nil {
+		f.Close()
+		return fmt.Errorf("journalling completed key: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	s.completed[key] = true
+	if err := os.Remove(s.batchPath(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Pending returns unexecuted batches, oldest first.
+func (s *FileQueueStore) Pending() ([]*QueuedBatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*QueuedBatch
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".batch.json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var batch QueuedBatch
+		if err := json.Unmarshal(data, &batch); err != nil {
+			return nil, fmt.Errorf("corrupt queued batch %s: %w", entry.Name(), err)
+		}
+		if s.completed[batch.Key] {
+			continue
+		}
+		pending = append(pending, &batch)
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].EnqueuedAt.Before(pending[j].EnqueuedAt)
+	})
+	return pending, nil
+}
@@ -0,0 +1,135 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileQueueStore_AppendCompletePending(t *testing.T) {
+	store, err := NewFileQueueStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileQueueStore() error = %v", err)
+	}
+
+	batch := &QueuedBatch{
+		Key:        "key-1",
+		Requests:   []SubRequest{{ID: "1", TenantID: "t", Recipe: "r"}},
+		EnqueuedAt: time.Now(),
+	}
+	if err := store.Append(batch); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	pending, err := store.Pending()
+	if err != nil {
+		t.Fatalf("Pending() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].Key != "key-1" {
+		t.Fatalf("Pending() = %v, want one batch with key-1", pending)
+	}
+	if len(pending[0].Requests) != 1 || pending[0].Requests[0].ID != "1" {
+		t.Errorf("pending requests = %v, want the enqueued request", pending[0].Requests)
+	}
+
+	if err := store.Complete("key-1"); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	pending, _ = store.Pending()
+	if len(pending) != 0 {
+		t.Errorf("Pending() after Complete = %v, want empty", pending)
+	}
+}
+
+func TestFileQueueStore_DuplicateKeys(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewFileQueueStore(dir)
+
+	batch := &QueuedBatch{Key: "dup", EnqueuedAt: time.Now()}
+	if err := store.Append(batch); err != nil {
+		t.Fatalf("first Append() error = %v", err)
+	}
+	if err := store.Append(batch); !errors.Is(err, ErrDuplicateBatch) {
+		t.Errorf("pending duplicate: error = %v, want ErrDuplicateBatch", err)
+	}
+
+	store.Complete("dup")
+	if err := store.Append(batch); !errors.Is(err, ErrDuplicateBatch) {
+		t.Errorf("completed duplicate: error = %v, want ErrDuplicateBatch", err)
+	}
+
+	// The completed-key journal survives reopening the store.
+	reopened, err := NewFileQueueStore(dir)
+	if err != nil {
+		t.Fatalf("reopening store: %v", err)
+	}
+	if err := reopened.Append(batch); !errors.Is(err, ErrDuplicateBatch) {
+		t.Errorf("completed duplicate after reopen: error = %v, want ErrDuplicateBatch", err)
+	}
+}
+
+func TestQueue_ResumesPendingBatchesOnStart(t *testing.T) {
+	dir := t.TempDir()
+
+	// Simulate a crashed process that persisted a batch but never ran it.
+	crashed, _ := NewFileQueueStore(dir)
+	crashed.Append(&QueuedBatch{
+		Key:        "interrupted",
+		Requests:   []SubRequest{{ID: "1", TenantID: "t", Recipe: "count"}},
+		EnqueuedAt: time.Now(),
+	})
+
+	orch := New()
+	var executed atomic.Int64
+	orch.RegisterRecipe("count", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed.Add(1)
+		return nil, nil
+	})
+
+	store, _ := NewFileQueueStore(dir)
+	q := NewQueue(orch, 10, 1, WithQueueStore(store))
+	if err := q.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) && executed.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if executed.Load() != 1 {
+		t.Fatalf("recovered batch executed %d times, want 1", executed.Load())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	q.Stop(ctx)
+
+	// A second restart must not re-execute the completed batch.
+	store2, _ := NewFileQueueStore(dir)
+	pending, _ := store2.Pending()
+	if len(pending) != 0 {
+		t.Errorf("Pending() after completion = %v, want empty", pending)
+	}
+}
+
+func TestQueue_EnqueueKeyedRejectsDuplicates(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	store, _ := NewFileQueueStore(t.TempDir())
+	q := NewQueue(orch, 10, 1, WithQueueStore(store))
+	q.Start()
+	defer q.Stop(context.Background())
+
+	batch := []SubRequest{{ID: "1", TenantID: "t", Recipe: "ok"}}
+	if err := q.EnqueueKeyed("once", batch); err != nil {
+		t.Fatalf("EnqueueKeyed() error = %v", err)
+	}
+	if err := q.EnqueueKeyed("once", batch); !errors.Is(err, ErrDuplicateBatch) {
+		t.Errorf("duplicate EnqueueKeyed(): error = %v, want ErrDuplicateBatch", err)
+	}
+}
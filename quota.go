@@ -0,0 +1,118 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// QuotaProvider answers whether a tenant has request quota remaining
+// (e.g. a daily or monthly allowance). Consume is called once per
+// request before execution and should atomically decrement the
+// tenant's remaining quota. Over-quota requests fail with a 429
+// QUOTA_EXCEEDED response carrying the reset time in Error.Details.
+//
+// A provider error admits the request (fail open): a quota backend
+// outage should degrade billing accuracy, not availability.
+// Implementations must be safe for concurrent use; back them with a
+// shared store such as Redis when quota spans orchestrator replicas.
+type QuotaProvider interface {
+	Consume(ctx context.Context, tenantID string) (QuotaDecision, error)
+}
+
+// QuotaDecision is the outcome of one quota consumption attempt.
+type QuotaDecision struct {
+	Allowed   bool      // Whether the request may proceed
+	Remaining int64     // Requests left in the current window
+	ResetAt   time.Time // When the quota replenishes
+}
+
+// WithQuotaProvider sets the quota check run before each request
+// executes, after rate limiting. Panics if provider is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithQuotaProvider(
+//		relayer.NewFixedWindowQuota(100_000, 24*time.Hour),
+//	))
+func WithQuotaProvider(provider QuotaProvider) Option {
+	return func(o *Orchestrator) {
+		if provider == nil {
+			panic("quota provider cannot be nil")
+		}
+		o.quota = provider
+	}
+}
+
+// quotaExceededResponse is the 429 returned for an over-quota request.
+func quotaExceededResponse(req SubRequest, decision QuotaDecision) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   429,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeQuotaExceeded,
+			Message: fmt.Sprintf("tenant '%s' exceeded its request quota", req.TenantID),
+			Details: map[string]interface{}{
+				"reset_at":       decision.ResetAt.Format(time.RFC3339),
+				"retry_after_ms": time.Until(decision.ResetAt).Milliseconds(),
+			},
+		},
+	}
+}
+
+// FixedWindowQuota is the reference QuotaProvider: an in-memory fixed
+// window granting each tenant limit requests per window, with windows
+// aligned to multiples of the window duration (a 24h window resets at
+// midnight UTC). Single-process only — replicas sharing a quota need a
+// QuotaProvider backed by a shared store.
+type FixedWindowQuota struct {
+	limit  int64
+	window time.Duration
+
+	mu     sync.Mutex
+	counts map[string]int64
+	start  time.Time // Start of the current window
+}
+
+// NewFixedWindowQuota builds an in-memory quota of limit requests per
+// tenant per window. Panics if limit < 1 or window <= 0.
+func NewFixedWindowQuota(limit int64, window time.Duration) *FixedWindowQuota {
+	if limit < 1 {
+		panic("quota limit must be at least 1")
+	}
+	if window <= 0 {
+		panic("quota window must be positive")
+	}
+	return &FixedWindowQuota{
+		limit:  limit,
+		window: window,
+		counts: make(map[string]int64),
+	}
+}
+
+// Consume implements QuotaProvider.
+func (q *FixedWindowQuota) Consume(ctx context.Context, tenantID string) (QuotaDecision, error) {
+	now := time.Now()
+	windowStart := now.Truncate(q.window)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.start.Equal(windowStart) {
+		q.start = windowStart
+		q.counts = make(map[string]int64)
+	}
+
+	resetAt := windowStart.Add(q.window)
+	if q.counts[tenantID] >= q.limit {
+		return QuotaDecision{Allowed: false, Remaining: 0, ResetAt: resetAt}, nil
+	}
+	q.counts[tenantID]++
+	return QuotaDecision{
+		Allowed:   true,
+		Remaining: q.limit - q.counts[tenantID],
+		ResetAt:   resetAt,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestQuota_OverQuotaReturns429(t *testing.T) {
+	orch := New(WithQuotaProvider(NewFixedWindowQuota(2, time.Hour)))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	for i, wantStatus := range []int{200, 200, 429} {
+		results := orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+		})
+		if results[0].Status != wantStatus {
+			t.Errorf("request %d: Status = %d, want %d", i+1, results[0].Status, wantStatus)
+		}
+	}
+}
+
+func TestQuota_DetailsCarryResetTime(t *testing.T) {
+	orch := New(WithQuotaProvider(NewFixedWindowQuota(1, time.Hour)))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeQuotaExceeded {
+		t.Fatalf("Error = %v, want code %s", results[0].Error, ErrCodeQuotaExceeded)
+	}
+	resetAt, _ := results[0].Error.Details["reset_at"].(string)
+	parsed, err := time.Parse(time.RFC3339, resetAt)
+	if err != nil {
+		t.Fatalf("reset_at = %q, want RFC 3339: %v", resetAt, err)
+	}
+	if !parsed.After(time.Now()) {
+		t.Errorf("reset_at = %v, want in the future", parsed)
+	}
+}
+
+func TestQuota_TenantsAreIndependent(t *testing.T) {
+	orch := New(WithQuotaProvider(NewFixedWindowQuota(1, time.Hour)))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("tenant-b Status = %d, want 200 (quota is per tenant)", results[0].Status)
+	}
+}
+
+type failingQuota struct{}
+
+func (failingQuota) Consume(ctx context.Context, tenantID string) (QuotaDecision, error) {
+	return QuotaDecision{}, errors.New("backend unavailable")
+}
+
+func TestQuota_ProviderErrorFailsOpen(t *testing.T) {
+	orch := New(WithQuotaProvider(failingQuota{}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 when the quota backend errors", results[0].Status)
+	}
+}
+
+func TestNewFixedWindowQuota_InvalidArgsPanic(t *testing.T) {
+	for name, build := range map[string]func(){
+		"zero limit":  func() { NewFixedWindowQuota(0, time.Hour) },
+		"zero window": func() { NewFixedWindowQuota(1, 0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: NewFixedWindowQuota did not panic", name)
+				}
+			}()
+			build()
+		}()
+	}
+}
@@ -0,0 +1,104 @@
+package relayer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// tenantRateLimiter enforces a token-bucket rate limit per tenant.
+// Buckets are created lazily on first use and refill continuously at
+// the configured rate up to the burst capacity.
+type tenantRateLimiter struct {
+	rps   float64
+	burst float64
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// WithTenantRateLimit limits each tenant to rps requests per second with
+// the given burst capacity, enforced across batches. Requests over budget
+// receive a 429 response with a retry-after hint in Error.Details.
+// Panics if rps <= 0 or burst < 1.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithTenantRateLimit(100, 20))
+func WithTenantRateLimit(rps float64, burst int) Option {
+	return func(o *Orchestrator) {
+		if rps <= 0 {
+			panic("tenant rate limit rps must be positive")
+		}
+		if burst < 1 {
+			panic("tenant rate limit burst must be at least 1")
+		}
+		o.rateLimiter = &tenantRateLimiter{
+			rps:     rps,
+			burst:   float64(burst),
+			buckets: make(map[string]*tokenBucket),
+		}
+	}
+}
+
+// allow consumes one token for the tenant if available. When the tenant
+// is over budget it returns false and the time until a token refills.
+func (l *tenantRateLimiter) allow(tenantID string) (bool, time.Duration) {
+	return l.allowRate(tenantID, l.rps, l.burst)
+}
+
+// allowRate is allow with an explicit rate, so per-tenant config
+// overrides can differ from the limiter's default. A non-positive rps
+// admits unconditionally.
+func (l *tenantRateLimiter) allowRate(tenantID string, rps, burst float64) (bool, time.Duration) {
+	if rps <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b, exists := l.buckets[tenantID]
+	if !exists {
+		b = &tokenBucket{tokens: burst, last: now}
+		l.buckets[tenantID] = b
+	}
+
+	// Refill based on elapsed time, capped at burst.
+	b.tokens += now.Sub(b.last).Seconds() * rps
+	if b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	// Time until one full token is available.
+	deficit := 1 - b.tokens
+	return false, time.Duration(deficit / rps * float64(time.Second))
+}
+
+// limitResponse builds the 429 response for a rate-limited request.
+func (l *tenantRateLimiter) limitResponse(req SubRequest, retryAfter time.Duration) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   429,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeRateLimited,
+			Message: fmt.Sprintf("tenant '%s' exceeded rate limit", req.TenantID),
+			Details: map[string]interface{}{
+				"retry_after_ms": retryAfter.Milliseconds(),
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTenantRateLimit_EnforcesBurst(t *testing.T) {
+	// 1 rps with burst 2: the third request in quick succession is limited.
+	orch := New(WithTenantRateLimit(1, 2))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	}
+
+	statuses := make([]int, 3)
+	for i := 0; i < 3; i++ {
+		results := orch.ExecuteBatch(context.Background(), batch)
+		statuses[i] = results[0].Status
+	}
+
+	if statuses[0] != 200 || statuses[1] != 200 {
+		t.Errorf("First two requests = %v, want 200s (within burst)", statuses[:2])
+	}
+
+	if statuses[2] != 429 {
+		t.Errorf("Third request status = %d, want 429", statuses[2])
+	}
+}
+
+func TestTenantRateLimit_TenantsAreIndependent(t *testing.T) {
+	orch := New(WithTenantRateLimit(1, 1))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	// Exhaust tenant-a's budget.
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	// tenant-b still has a full bucket.
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("tenant-b status = %d, want 200", results[0].Status)
+	}
+}
+
+func TestTenantRateLimit_RetryAfterHint(t *testing.T) {
+	orch := New(WithTenantRateLimit(1, 1))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "echo"}}
+
+	orch.ExecuteBatch(context.Background(), batch)
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if results[0].Status != 429 {
+		t.Fatalf("Status = %d, want 429", results[0].Status)
+	}
+
+	if results[0].Error.Code != ErrCodeRateLimited {
+		t.Errorf("Error code = %s, want %s", results[0].Error.Code, ErrCodeRateLimited)
+	}
+
+	if _, ok := results[0].Error.Details["retry_after_ms"]; !ok {
+		t.Error("Expected retry_after_ms in error details")
+	}
+}
+
+func TestTenantRateLimit_BucketRefills(t *testing.T) {
+	orch := New(WithTenantRateLimit(50, 1))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{{ID: "1", TenantID: "tenant-a", Recipe: "echo"}}
+
+	orch.ExecuteBatch(context.Background(), batch)
+
+	// At 50 rps a token refills within 20ms.
+	time.Sleep(30 * time.Millisecond)
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+	if results[0].Status != 200 {
+		t.Errorf("Status after refill = %d, want 200", results[0].Status)
+	}
+}
@@ -0,0 +1,126 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRecipeOption_MaxConcurrency(t *testing.T) {
+	orch := New()
+
+	var current, peak atomic.Int32
+	var mu sync.Mutex
+
+	orch.RegisterRecipe("throttled", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		now := current.Add(1)
+		mu.Lock()
+		if now > peak.Load() {
+			peak.Store(now)
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		current.Add(-1)
+		return nil, nil
+	}, &RecipeOption{MaxConcurrency: 2})
+
+	batch := make([]SubRequest, 10)
+	for i := range batch {
+		batch[i] = SubRequest{ID: "r", TenantID: "t", Recipe: "throttled"}
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Fatalf("results[%d].Status = %d, want 200", i, resp.Status)
+		}
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", got)
+	}
+}
+
+func TestRecipeOption_MaxConcurrency_DoesNotThrottleOtherRecipes(t *testing.T) {
+	orch := New()
+
+	blocked := make(chan struct{})
+	started := make(chan struct{})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		close(started)
+		<-blocked
+		return nil, nil
+	}, &RecipeOption{MaxConcurrency: 1})
+
+	orch.RegisterRecipe("fast", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "quick", nil
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "slow"},
+		})
+	}()
+	<-started
+
+	// The fast recipe must complete while slow's only slot is occupied.
+	done := make(chan []Response, 1)
+	go func() {
+		done <- orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "2", TenantID: "t", Recipe: "fast"},
+		})
+	}()
+
+	select {
+	case results := <-done:
+		if results[0].Status != 200 {
+			t.Errorf("fast recipe status = %d, want 200", results[0].Status)
+		}
+	case <-time.After(time.Second):
+		t.Error("fast recipe blocked behind slow recipe's concurrency limit")
+	}
+
+	close(blocked)
+	wg.Wait()
+}
+
+func TestRecipeOption_MaxConcurrency_CancelledWhileWaiting(t *testing.T) {
+	orch := New(WithTimeout(10 * time.Second))
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	orch.RegisterRecipe("single", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	}, &RecipeOption{MaxConcurrency: 1})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "single"},
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	results := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "2", TenantID: "t", Recipe: "single"},
+	})
+
+	if results[0].Status != 504 {
+		t.Errorf("queued request status = %d, want 504 after cancellation", results[0].Status)
+	}
+
+	close(release)
+	wg.Wait()
+}
@@ -0,0 +1,365 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Step describes one node of a composite recipe: either a reference to
+// an existing recipe by name, or a composition of further steps.
+// Exactly one field must be set. In JSON a bare string is shorthand for
+// a recipe reference, so compositions read naturally:
+//
+//	{"sequence": ["get-user", {"parallel": ["audit", "notify"]}]}
+type Step struct {
+	// Recipe references a registered recipe by name. Resolution happens
+	// at execution time, so referenced recipes may be registered (or
+	// swapped) after the composite is loaded.
+	Recipe string `json:"recipe,omitempty"`
+
+	// Sequence chains steps: each receives the previous step's output,
+	// and the last step's output is the result.
+	Sequence []Step `json:"sequence,omitempty"`
+
+	// Parallel runs steps concurrently, each receiving the same input.
+	// The result is a slice of outputs in config order. The first error
+	// fails the whole step.
+	Parallel []Step `json:"parallel,omitempty"`
+
+	// Conditional branches on the outcome of an inner step.
+	Conditional *ConditionalStep `json:"conditional,omitempty"`
+}
+
+// ConditionalStep runs If and branches on its outcome. Without When,
+// success takes the Then branch and an error takes Else. With When,
+// the predicate decides: matched takes Then, unmatched takes Else.
+// Then receives If's output (the original input when If errored); Else
+// receives the original input. An omitted branch passes If's outcome
+// through unchanged. The branch taken is recorded under "branches" in
+// Response.Meta (Error.Details for failed requests).
+type ConditionalStep struct {
+	If   Step           `json:"if"`
+	When *StepPredicate `json:"when,omitempty"`
+	Then *Step          `json:"then,omitempty"`
+	Else *Step          `json:"else,omitempty"`
+}
+
+// StepPredicate decides a conditional's branch from the If step's
+// outcome. Exactly one matching form must be set.
+//
+// Example — create the user only when lookup failed with a 404-style
+// error:
+//
+//	{"conditional": {
+//	  "if":   "find-user",
+//	  "when": {"error_code": "RECIPE_EXECUTION"},
+//	  "then": "create-user"
+//	}}
+type StepPredicate struct {
+	// Field selects a dotted path into the If step's output (maps with
+	// string keys); empty selects the output itself. Only consulted by
+	// Equals and Exists.
+	Field string `json:"field,omitempty"`
+
+	// Equals matches when the selected value equals this, with JSON
+	// number semantics (all numbers compare as float64). Never matches
+	// when If errored.
+	Equals interface{} `json:"equals,omitempty"`
+
+	// Exists matches on the presence (true) or absence (false) of the
+	// selected field. Never matches when If errored.
+	Exists *bool `json:"exists,omitempty"`
+
+	// ErrorCode matches when If failed with a *relayer.Error carrying
+	// this code.
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// validate checks that exactly one matching form is set.
+func (p *StepPredicate) validate() error {
+	forms := 0
+	if p.Equals != nil {
+		forms++
+	}
+	if p.Exists != nil {
+		forms++
+	}
+	if p.ErrorCode != "" {
+		forms++
+	}
+	if forms != 1 {
+		return fmt.Errorf("predicate must set exactly one of equals, exists, error_code")
+	}
+	return nil
+}
+
+// matches evaluates the predicate against the If step's outcome.
+func (p *StepPredicate) matches(result interface{}, err error) bool {
+	if p.ErrorCode != "" {
+		var e *Error
+		return errors.As(err, &e) && e.Code == p.ErrorCode
+	}
+	if err != nil {
+		return false
+	}
+
+	value, found := lookupMetaField(result, p.Field)
+	if p.Exists != nil {
+		return found == *p.Exists
+	}
+	if !found {
+		return false
+	}
+	return reflect.DeepEqual(normalizeNumber(value), normalizeNumber(p.Equals))
+}
+
+// lookupMetaField walks a dotted path through nested string-keyed maps.
+func lookupMetaField(value interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return value, true
+	}
+	for _, part := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok = obj[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// normalizeNumber widens every numeric type to float64 so handler
+// outputs (often int) compare equal to config literals (float64 after
+// JSON decoding).
+func normalizeNumber(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	}
+	return v
+}
+
+// UnmarshalJSON accepts either a bare recipe name or a step object.
+func (s *Step) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &s.Recipe)
+	}
+	type plain Step // avoid recursing into this method
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// validate checks that exactly one composition form is set, recursively.
+func (s Step) validate() error {
+	forms := 0
+	if s.Recipe != "" {
+		forms++
+	}
+	if len(s.Sequence) > 0 {
+		forms++
+	}
+	if len(s.Parallel) > 0 {
+		forms++
+	}
+	if s.Conditional != nil {
+		forms++
+	}
+	if forms != 1 {
+		return fmt.Errorf("step must set exactly one of recipe, sequence, parallel, conditional")
+	}
+
+	for _, sub := range s.Sequence {
+		if err := sub.validate(); err != nil {
+			return err
+		}
+	}
+	for _, sub := range s.Parallel {
+		if err := sub.validate(); err != nil {
+			return err
+		}
+	}
+	if c := s.Conditional; c != nil {
+		if err := c.If.validate(); err != nil {
+			return err
+		}
+		if c.When != nil {
+			if err := c.When.validate(); err != nil {
+				return err
+			}
+		}
+		if c.Then != nil {
+			if err := c.Then.validate(); err != nil {
+				return err
+			}
+		}
+		if c.Else != nil {
+			if err := c.Else.validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RegisterComposite registers a recipe assembled from existing recipes.
+// Like RegisterPipeline, referenced recipes are resolved at execution
+// time and the whole composition runs within one timeout and context.
+// Panics if the name is empty or the step is malformed, matching
+// RegisterRecipe's validation behavior.
+//
+// Example:
+//
+//	orch.RegisterComposite("upsert-user", relayer.Step{
+//		Conditional: &relayer.ConditionalStep{
+//			If:   relayer.Step{Recipe: "find-user"},
+//			Else: &relayer.Step{Recipe: "create-user"},
+//		},
+//	})
+func (o *Orchestrator) RegisterComposite(name string, step Step, opts ...*RecipeOption) {
+	if name == "" {
+		panic("composite recipe name cannot be empty")
+	}
+	if err := step.validate(); err != nil {
+		panic(fmt.Sprintf("composite recipe '%s': %v", name, err))
+	}
+
+	o.RegisterRecipe(name, func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return o.runStep(ctx, name, step, payload)
+	}, opts...)
+}
+
+// LoadRecipeConfig reads a JSON document defining composite recipes and
+// registers each, returning the registered names. The document is an
+// object mapping recipe names to steps:
+//
+//	{
+//	  "enrich-user": {"sequence": ["get-user", "get-permissions", "merge"]},
+//	  "notify-all":  {"parallel": ["email", "sms"]},
+//	  "upsert-user": {"conditional": {"if": "find-user", "else": "create-user"}}
+//	}
+//
+// Nothing is registered if any definition is invalid, so a bad config
+// never partially applies. YAML configs can be converted to JSON before
+// loading.
+func (o *Orchestrator) LoadRecipeConfig(r io.Reader) ([]string, error) {
+	var config map[string]Step
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return nil, fmt.Errorf("invalid recipe config: %w", err)
+	}
+
+	names := make([]string, 0, len(config))
+	for name, step := range config {
+		if name == "" {
+			return nil, fmt.Errorf("recipe config: recipe name cannot be empty")
+		}
+		if err := step.validate(); err != nil {
+			return nil, fmt.Errorf("recipe config: recipe %q: %w", name, err)
+		}
+		names = append(names, name)
+	}
+
+	for _, name := range names {
+		o.RegisterComposite(name, config[name])
+	}
+	return names, nil
+}
+
+// runStep executes one node of a composite recipe.
+func (o *Orchestrator) runStep(ctx context.Context, name string, step Step, payload interface{}) (interface{}, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	switch {
+	case step.Recipe != "":
+		o.mu.RLock()
+		handler, exists := o.registry[step.Recipe]
+		o.mu.RUnlock()
+		if !exists {
+			return nil, fmt.Errorf("composite '%s' step '%s': recipe not found", name, step.Recipe)
+		}
+		result, err := handler(ctx, payload)
+		if err != nil {
+			return nil, fmt.Errorf("composite '%s' step '%s': %w", name, step.Recipe, err)
+		}
+		return result, nil
+
+	case len(step.Sequence) > 0:
+		current := payload
+		for _, sub := range step.Sequence {
+			result, err := o.runStep(ctx, name, sub, current)
+			if err != nil {
+				return nil, err
+			}
+			current = result
+		}
+		return current, nil
+
+	case len(step.Parallel) > 0:
+		results := make([]interface{}, len(step.Parallel))
+		errs := make([]error, len(step.Parallel))
+		var wg sync.WaitGroup
+		for i, sub := range step.Parallel {
+			wg.Add(1)
+			go func(i int, sub Step) {
+				defer wg.Done()
+				results[i], errs[i] = o.runStep(ctx, name, sub, payload)
+			}(i, sub)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return results, nil
+
+	case step.Conditional != nil:
+		return o.runConditional(ctx, name, step.Conditional, payload)
+
+	default:
+		return nil, fmt.Errorf("composite '%s': empty step", name)
+	}
+}
+
+// runConditional executes a conditional step's branches, recording the
+// branch taken as a response annotation.
+func (o *Orchestrator) runConditional(ctx context.Context, name string, cond *ConditionalStep, payload interface{}) (interface{}, error) {
+	result, err := o.runStep(ctx, name, cond.If, payload)
+
+	takeThen := err == nil
+	if cond.When != nil {
+		takeThen = cond.When.matches(result, err)
+	}
+
+	if takeThen {
+		appendResponseMeta(ctx, "branches", "then")
+		if cond.Then == nil {
+			return result, err
+		}
+		input := result
+		if err != nil {
+			input = payload
+		}
+		return o.runStep(ctx, name, *cond.Then, input)
+	}
+
+	appendResponseMeta(ctx, "branches", "else")
+	if cond.Else == nil {
+		return result, err
+	}
+	return o.runStep(ctx, name, *cond.Else, payload)
+}
@@ -0,0 +1,135 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func compositeTestOrch() *Orchestrator {
+	orch := New()
+	orch.RegisterRecipe("double", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) * 2, nil
+	})
+	orch.RegisterRecipe("inc", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload.(int) + 1, nil
+	})
+	orch.RegisterRecipe("boom", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("not found")
+	})
+	return orch
+}
+
+func TestLoadRecipeConfig_Sequence(t *testing.T) {
+	orch := compositeTestOrch()
+
+	names, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"double-then-inc": {"sequence": ["double", "inc"]}
+	}`))
+	if err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+	if len(names) != 1 || names[0] != "double-then-inc" {
+		t.Fatalf("LoadRecipeConfig() = %v, want [double-then-inc]", names)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "double-then-inc", Payload: 5},
+	})
+	if results[0].Status != 200 || results[0].Data != 11 {
+		t.Errorf("got (%d, %v), want (200, 11)", results[0].Status, results[0].Data)
+	}
+}
+
+func TestLoadRecipeConfig_Parallel(t *testing.T) {
+	orch := compositeTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"both": {"parallel": ["double", "inc"]}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "both", Payload: 5},
+	})
+	want := []interface{}{10, 6}
+	if !reflect.DeepEqual(results[0].Data, want) {
+		t.Errorf("Data = %v, want %v in config order", results[0].Data, want)
+	}
+}
+
+func TestLoadRecipeConfig_ConditionalElseOnError(t *testing.T) {
+	orch := compositeTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"find-or-create": {"conditional": {"if": "boom", "else": "inc"}},
+		"found":          {"conditional": {"if": "double", "then": "inc"}}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "find-or-create", Payload: 5},
+		{ID: "2", TenantID: "t", Recipe: "found", Payload: 5},
+	})
+
+	// boom fails, so else runs with the original payload: 5 + 1.
+	if results[0].Data != 6 {
+		t.Errorf("else branch Data = %v, want 6", results[0].Data)
+	}
+	// double succeeds, so then runs with its output: 5*2 + 1.
+	if results[1].Data != 11 {
+		t.Errorf("then branch Data = %v, want 11", results[1].Data)
+	}
+}
+
+func TestLoadRecipeConfig_NestedSteps(t *testing.T) {
+	orch := compositeTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"nested": {"sequence": ["double", {"parallel": ["double", "inc"]}]}
+	}`)); err != nil {
+		t.Fatalf("LoadRecipeConfig() error: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "nested", Payload: 3},
+	})
+	want := []interface{}{12, 7}
+	if !reflect.DeepEqual(results[0].Data, want) {
+		t.Errorf("Data = %v, want %v", results[0].Data, want)
+	}
+}
+
+func TestLoadRecipeConfig_InvalidConfigRegistersNothing(t *testing.T) {
+	orch := compositeTestOrch()
+
+	if _, err := orch.LoadRecipeConfig(strings.NewReader(`{
+		"good": {"sequence": ["double"]},
+		"bad":  {"recipe": "double", "sequence": ["inc"]}
+	}`)); err == nil {
+		t.Fatal("LoadRecipeConfig() succeeded with an ambiguous step, want error")
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "good", Payload: 1},
+	})
+	if results[0].Status != 404 {
+		t.Errorf("Status = %d, want 404: a bad config must not partially apply", results[0].Status)
+	}
+}
+
+func TestRegisterComposite_MissingRecipeFailsRequest(t *testing.T) {
+	orch := New()
+	orch.RegisterComposite("broken", Step{Recipe: "ghost"})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "broken"},
+	})
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500 for a missing referenced recipe", results[0].Status)
+	}
+}
@@ -0,0 +1,48 @@
+package relayer
+
+import (
+	"sort"
+	"time"
+)
+
+// RecipeInfo describes a registered recipe: its name, configured
+// options, informational metadata, and when it was registered.
+type RecipeInfo struct {
+	Name         string        `json:"name"`
+	Description  string        `json:"description,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	Timeout      time.Duration `json:"timeout,omitempty"` // Per-recipe override; 0 = orchestrator default
+	MaxRetries   int           `json:"max_retries,omitempty"`
+	RegisteredAt time.Time     `json:"registered_at"`
+}
+
+// ListRecipes returns information about every registered recipe, sorted
+// by name. Intended for admin UIs and self-documenting batch endpoints.
+//
+// Example:
+//
+//	for _, info := range orch.ListRecipes() {
+//		fmt.Printf("%s: %s\n", info.Name, info.Description)
+//	}
+func (o *Orchestrator) ListRecipes() []RecipeInfo {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	infos := make([]RecipeInfo, 0, len(o.registry))
+	for name := range o.registry {
+		info := RecipeInfo{
+			Name:         name,
+			RegisteredAt: o.registeredAt[name],
+		}
+		if opt, exists := o.recipeOptions[name]; exists {
+			info.Description = opt.Description
+			info.Tags = append([]string(nil), opt.Tags...)
+			info.Timeout = opt.Timeout
+			info.MaxRetries = opt.MaxRetries
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
@@ -0,0 +1,58 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListRecipes_MetadataAndSorting(t *testing.T) {
+	orch := New()
+
+	handler := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	}
+
+	orch.RegisterRecipe("zeta", handler)
+	orch.RegisterRecipe("alpha", handler, &RecipeOption{
+		Timeout:     30 * time.Second,
+		Description: "First recipe",
+		Tags:        []string{"core", "fast"},
+	})
+
+	infos := orch.ListRecipes()
+
+	if len(infos) != 2 {
+		t.Fatalf("ListRecipes returned %d entries, want 2", len(infos))
+	}
+
+	if infos[0].Name != "alpha" || infos[1].Name != "zeta" {
+		t.Errorf("Order = [%s, %s], want [alpha, zeta]", infos[0].Name, infos[1].Name)
+	}
+
+	if infos[0].Description != "First recipe" {
+		t.Errorf("Description = %q, want 'First recipe'", infos[0].Description)
+	}
+
+	if len(infos[0].Tags) != 2 || infos[0].Tags[0] != "core" {
+		t.Errorf("Tags = %v, want [core fast]", infos[0].Tags)
+	}
+
+	if infos[0].Timeout != 30*time.Second {
+		t.Errorf("Timeout = %v, want 30s", infos[0].Timeout)
+	}
+
+	for _, info := range infos {
+		if info.RegisteredAt.IsZero() {
+			t.Errorf("Recipe %s has zero RegisteredAt", info.Name)
+		}
+	}
+}
+
+func TestListRecipes_Empty(t *testing.T) {
+	orch := New()
+
+	if infos := orch.ListRecipes(); len(infos) != 0 {
+		t.Errorf("ListRecipes on empty orchestrator = %v, want empty", infos)
+	}
+}
@@ -0,0 +1,155 @@
+package relayer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// RecordedExchange is one NDJSON line written by WithRecorder: the
+// request exactly as it was executed and the response it produced.
+type RecordedExchange struct {
+	Request    SubRequest `json:"request"`
+	Response   Response   `json:"response"`
+	RecordedAt time.Time  `json:"recorded_at"`
+}
+
+// ReplayDivergence describes a recorded request whose live response
+// differed from the recorded one in status, error code, or data.
+type ReplayDivergence struct {
+	Request  SubRequest
+	Recorded Response
+	Live     Response
+}
+
+// recorder serializes completed exchanges to a writer as NDJSON.
+type recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// WithRecorder writes every completed (request, response) pair to w as
+// newline-delimited JSON, one RecordedExchange per line. Recordings
+// capture the original request payloads — not the redacted copies hooks
+// receive — so they can be replayed later; treat recording files as
+// sensitive. Writes are serialized and best-effort: encode errors are
+// ignored. Panics if w is nil.
+//
+// Example:
+//
+//	f, _ := os.Create("traffic.ndjson")
+//	orch := relayer.New(relayer.WithRecorder(f))
+func WithRecorder(w io.Writer) Option {
+	return func(o *Orchestrator) {
+		if w == nil {
+			panic("recorder writer cannot be nil")
+		}
+		o.recorder = &recorder{enc: json.NewEncoder(w)}
+	}
+}
+
+// record appends one exchange. Best-effort: encode errors are ignored.
+func (r *recorder) record(req SubRequest, resp Response, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = r.enc.Encode(RecordedExchange{Request: req, Response: resp, RecordedAt: now})
+}
+
+// Replay re-executes recorded traffic against the given executor and
+// returns the requests whose live responses diverged from the recorded
+// ones (different status, error code, or data). Requests are replayed
+// one at a time in recorded order. Both sides of the data comparison
+// are normalized through a JSON round trip, so a recorded response read
+// back from disk compares equal to a freshly produced identical one.
+//
+// Example:
+//
+//	f, _ := os.Open("traffic.ndjson")
+//	diffs, err := relayer.Replay(f, candidate)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, d := range diffs {
+//		log.Printf("recipe %s diverged: recorded %d, live %d",
+//			d.Request.Recipe, d.Recorded.Status, d.Live.Status)
+//	}
+func Replay(r io.Reader, orch Executor) ([]ReplayDivergence, error) {
+	if orch == nil {
+		panic("replay executor cannot be nil")
+	}
+
+	var divergences []ReplayDivergence
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := scanner.Bytes()
+		if len(raw) == 0 {
+			continue
+		}
+		var rec RecordedExchange
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return divergences, fmt.Errorf("replay: line %d: %w", line, err)
+		}
+
+		results := orch.ExecuteBatch(context.Background(), []SubRequest{rec.Request})
+		if len(results) != 1 {
+			return divergences, fmt.Errorf("replay: line %d: got %d results, want 1", line, len(results))
+		}
+		live := results[0]
+		if replayDiverged(rec.Response, live) {
+			divergences = append(divergences, ReplayDivergence{
+				Request:  rec.Request,
+				Recorded: rec.Response,
+				Live:     live,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return divergences, fmt.Errorf("replay: %w", err)
+	}
+	return divergences, nil
+}
+
+// replayDiverged reports whether the live response differs from the
+// recorded one in status, error code, or JSON-normalized data.
+func replayDiverged(recorded, live Response) bool {
+	if recorded.Status != live.Status {
+		return true
+	}
+	recordedCode, liveCode := "", ""
+	if recorded.Error != nil {
+		recordedCode = recorded.Error.Code
+	}
+	if live.Error != nil {
+		liveCode = live.Error.Code
+	}
+	if recordedCode != liveCode {
+		return true
+	}
+	return !reflect.DeepEqual(jsonNormalize(recorded.Data), jsonNormalize(live.Data))
+}
+
+// jsonNormalize round-trips a value through JSON so recorded data
+// (decoded as generic maps and float64s) compares equal to the native
+// types a live handler returns.
+func jsonNormalize(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return v
+	}
+	return out
+}
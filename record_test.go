@@ -0,0 +1,121 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWithRecorder_WritesNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	orch := New(WithRecorder(&buf))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hi"},
+		{ID: "2", TenantID: "t", Recipe: "echo", Payload: "bye"},
+	})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("recorded %d lines, want 2", len(lines))
+	}
+	seen := map[string]bool{}
+	for _, line := range lines {
+		var rec RecordedExchange
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("line is not valid JSON: %v", err)
+		}
+		if rec.Response.Status != 200 {
+			t.Errorf("recorded Status = %d, want 200", rec.Response.Status)
+		}
+		if rec.RecordedAt.IsZero() {
+			t.Error("RecordedAt not set")
+		}
+		seen[rec.Request.ID] = true
+	}
+	if !seen["1"] || !seen["2"] {
+		t.Errorf("recorded IDs = %v, want both requests", seen)
+	}
+}
+
+func TestReplay_ReportsDivergences(t *testing.T) {
+	var buf bytes.Buffer
+	source := New(WithRecorder(&buf))
+	source.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	source.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "same"},
+		{ID: "2", TenantID: "t", Recipe: "echo", Payload: "changed"},
+	})
+
+	candidate := New()
+	candidate.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if payload == "changed" {
+			return "different", nil
+		}
+		return payload, nil
+	})
+
+	diffs, err := Replay(&buf, candidate)
+	if err != nil {
+		t.Fatalf("Replay returned error: %v", err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("got %d divergences, want 1", len(diffs))
+	}
+	if diffs[0].Request.ID != "2" {
+		t.Errorf("diverged request ID = %s, want 2", diffs[0].Request.ID)
+	}
+	if diffs[0].Live.Data != "different" {
+		t.Errorf("Live.Data = %v, want different", diffs[0].Live.Data)
+	}
+}
+
+func TestReplay_NormalizesJSONTypes(t *testing.T) {
+	var buf bytes.Buffer
+	source := New(WithRecorder(&buf))
+	source.RegisterRecipe("count", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return map[string]int{"n": 42}, nil
+	})
+	source.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "count"},
+	})
+
+	// The candidate returns the same logical value; the recorded copy
+	// comes back from JSON as map[string]interface{} with float64.
+	candidate := New()
+	candidate.RegisterRecipe("count", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return map[string]int{"n": 42}, nil
+	})
+
+	diffs, err := Replay(&buf, candidate)
+	if err != nil {
+		t.Fatalf("Replay returned error: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("got %d divergences, want 0 for identical data", len(diffs))
+	}
+}
+
+func TestReplay_MalformedLineReturnsError(t *testing.T) {
+	orch := New()
+	_, err := Replay(strings.NewReader("not json\n"), orch)
+	if err == nil {
+		t.Error("Replay did not report the malformed line")
+	}
+}
+
+func TestWithRecorder_NilWriterPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithRecorder(nil) did not panic")
+		}
+	}()
+	New(WithRecorder(nil))
+}
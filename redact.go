@@ -0,0 +1,152 @@
+package relayer
+
+import "strings"
+
+// Redactor transforms a payload before it is exposed outside the
+// execution path — to execution/error/panic/queue/audit hooks and late
+// result delivery. Use it to strip or mask fields that must not reach
+// logs or alerting integrations. The handler itself always sees the
+// original payload.
+type Redactor func(payload interface{}) interface{}
+
+// WithRedactor sets the redactor applied to payload copies handed to
+// hooks. Independent of the redactor, map keys that look sensitive
+// ("password", "token", "secret", ...) are always masked in hook
+// payloads and in Error.Details; the redactor is for shapes the
+// key-based masking cannot see, such as credentials embedded in
+// strings or custom structs.
+//
+// Dead letters keep the original payload so failed requests can be
+// replayed verbatim.
+//
+// Panics if fn is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithRedactor(func(payload interface{}) interface{} {
+//		if s, ok := payload.(string); ok {
+//			return creditCardPattern.ReplaceAllString(s, "****")
+//		}
+//		return payload
+//	}))
+func WithRedactor(fn Redactor) Option {
+	return func(o *Orchestrator) {
+		if fn == nil {
+			panic("redactor cannot be nil")
+		}
+		o.redactor = fn
+	}
+}
+
+// redactedValue replaces values of sensitive keys.
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeyFragments flag a map key as sensitive when its lowercase
+// form contains any of them.
+var sensitiveKeyFragments = []string{
+	"password",
+	"passwd",
+	"token",
+	"secret",
+	"api_key",
+	"apikey",
+	"authorization",
+	"credential",
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(lower, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskSensitiveKeys walks maps and slices, replacing values of
+// sensitive keys with redactedValue. The input is never mutated:
+// containers are copied only on the paths where masking occurred, and
+// the original value is returned unchanged when nothing was masked.
+func maskSensitiveKeys(v interface{}) interface{} {
+	masked, _ := maskValue(v)
+	return masked
+}
+
+func maskValue(v interface{}) (interface{}, bool) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		changed := false
+		out := val
+		for k, elem := range val {
+			var replacement interface{}
+			if isSensitiveKey(k) {
+				replacement = redactedValue
+			} else {
+				var elemChanged bool
+				replacement, elemChanged = maskValue(elem)
+				if !elemChanged {
+					continue
+				}
+			}
+			if !changed {
+				out = make(map[string]interface{}, len(val))
+				for ck, cv := range val {
+					out[ck] = cv
+				}
+				changed = true
+			}
+			out[k] = replacement
+		}
+		return out, changed
+	case []interface{}:
+		changed := false
+		out := val
+		for i, elem := range val {
+			replacement, elemChanged := maskValue(elem)
+			if !elemChanged {
+				continue
+			}
+			if !changed {
+				out = make([]interface{}, len(val))
+				copy(out, val)
+				changed = true
+			}
+			out[i] = replacement
+		}
+		return out, changed
+	default:
+		return v, false
+	}
+}
+
+// redactPayload applies the configured redactor (if any) followed by
+// automatic sensitive-key masking.
+func (o *Orchestrator) redactPayload(payload interface{}) interface{} {
+	if o.redactor != nil {
+		payload = o.redactor(payload)
+	}
+	return maskSensitiveKeys(payload)
+}
+
+// redactRequest returns a copy of req whose payload is safe to hand to
+// hooks.
+func (o *Orchestrator) redactRequest(req SubRequest) SubRequest {
+	req.Payload = o.redactPayload(req.Payload)
+	return req
+}
+
+// redactError masks sensitive keys in Error.Details, returning a copy
+// when masking changed anything so the original stays untouched.
+func redactError(e *Error) *Error {
+	if e == nil || e.Details == nil {
+		return e
+	}
+	masked, changed := maskValue(map[string]interface{}(e.Details))
+	if !changed {
+		return e
+	}
+	clone := *e
+	clone.Details = masked.(map[string]interface{})
+	return &clone
+}
@@ -0,0 +1,168 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// captureHook records the requests hooks saw so tests can inspect the
+// payloads that crossed the hook boundary.
+type captureHook struct {
+	mu       sync.Mutex
+	started  []SubRequest
+	complete []SubRequest
+}
+
+func (h *captureHook) OnStart(ctx context.Context, req SubRequest) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.started = append(h.started, req)
+}
+
+func (h *captureHook) OnComplete(ctx context.Context, req SubRequest, resp Response, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.complete = append(h.complete, req)
+}
+
+func TestMaskSensitiveKeys(t *testing.T) {
+	payload := map[string]interface{}{
+		"name":     "alice",
+		"password": "hunter2",
+		"nested": map[string]interface{}{
+			"api_key": "abc123",
+			"count":   3,
+		},
+		"items": []interface{}{
+			map[string]interface{}{"refresh_token": "xyz"},
+		},
+	}
+
+	masked := maskSensitiveKeys(payload).(map[string]interface{})
+
+	if masked["password"] != redactedValue {
+		t.Errorf("password = %v, want %s", masked["password"], redactedValue)
+	}
+	if masked["name"] != "alice" {
+		t.Errorf("name = %v, want alice", masked["name"])
+	}
+	nested := masked["nested"].(map[string]interface{})
+	if nested["api_key"] != redactedValue {
+		t.Errorf("nested api_key = %v, want %s", nested["api_key"], redactedValue)
+	}
+	item := masked["items"].([]interface{})[0].(map[string]interface{})
+	if item["refresh_token"] != redactedValue {
+		t.Errorf("refresh_token = %v, want %s", item["refresh_token"], redactedValue)
+	}
+
+	// The original must not be mutated
+	if payload["password"] != "hunter2" {
+		t.Errorf("original payload mutated: password = %v", payload["password"])
+	}
+}
+
+func TestMaskSensitiveKeys_NoChangeReturnsOriginal(t *testing.T) {
+	payload := map[string]interface{}{"name": "alice"}
+	masked := maskSensitiveKeys(payload)
+	if _, same := masked.(map[string]interface{}); !same {
+		t.Fatalf("masked = %T, want map", masked)
+	}
+	if masked.(map[string]interface{})["name"] != "alice" {
+		t.Errorf("masked = %v, want unchanged", masked)
+	}
+}
+
+func TestRedaction_HooksSeeMaskedPayload(t *testing.T) {
+	hook := &captureHook{}
+	orch := New(WithExecutionHook(hook))
+
+	var handlerSaw interface{}
+	orch.RegisterRecipe("login", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		handlerSaw = payload
+		return "ok", nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "login", Payload: map[string]interface{}{
+			"user":     "alice",
+			"password": "hunter2",
+		}},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.started) != 1 {
+		t.Fatalf("OnStart calls = %d, want 1", len(hook.started))
+	}
+	seen := hook.started[0].Payload.(map[string]interface{})
+	if seen["password"] != redactedValue {
+		t.Errorf("hook saw password = %v, want %s", seen["password"], redactedValue)
+	}
+	if seen["user"] != "alice" {
+		t.Errorf("hook saw user = %v, want alice", seen["user"])
+	}
+
+	// The handler gets the original, unredacted payload
+	if handlerSaw.(map[string]interface{})["password"] != "hunter2" {
+		t.Errorf("handler saw %v, want original payload", handlerSaw)
+	}
+}
+
+func TestWithRedactor_AppliedBeforeHooks(t *testing.T) {
+	hook := &captureHook{}
+	orch := New(
+		WithExecutionHook(hook),
+		WithRedactor(func(payload interface{}) interface{} {
+			if s, ok := payload.(string); ok {
+				return strings.ReplaceAll(s, "4111", "****")
+			}
+			return payload
+		}),
+	)
+	orch.RegisterRecipe("charge", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "charge", Payload: "card 4111-1111"},
+	})
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if got := hook.started[0].Payload; got != "card ****-1111" {
+		t.Errorf("hook saw %v, want redacted card number", got)
+	}
+}
+
+func TestRedaction_ErrorDetailsMasked(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("annotate", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		annotateResponse(ctx, "token", "abc123")
+		annotateResponse(ctx, "step", "validate")
+		return nil, context.DeadlineExceeded
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "annotate"},
+	})
+
+	details := results[0].Error.Details
+	if details["token"] != redactedValue {
+		t.Errorf("Details[token] = %v, want %s", details["token"], redactedValue)
+	}
+	if details["step"] != "validate" {
+		t.Errorf("Details[step] = %v, want validate", details["step"])
+	}
+}
+
+func TestWithRedactor_NilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithRedactor(nil) did not panic")
+		}
+	}()
+	New(WithRedactor(nil))
+}
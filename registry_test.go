@@ -0,0 +1,84 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnregisterRecipe(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("temp", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	}, &RecipeOption{Description: "temporary"})
+
+	if !orch.UnregisterRecipe("temp") {
+		t.Error("UnregisterRecipe returned false for registered recipe")
+	}
+
+	if orch.UnregisterRecipe("temp") {
+		t.Error("UnregisterRecipe returned true for already-removed recipe")
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "temp"},
+	})
+
+	if results[0].Status != 404 {
+		t.Errorf("Status after unregister = %d, want 404", results[0].Status)
+	}
+
+	if len(orch.ListRecipes()) != 0 {
+		t.Error("ListRecipes still shows unregistered recipe")
+	}
+}
+
+func TestReplaceRecipe(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("swap", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "old", nil
+	}, &RecipeOption{Description: "swappable"})
+
+	if err := orch.ReplaceRecipe("swap", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "new", nil
+	}); err != nil {
+		t.Fatalf("ReplaceRecipe failed: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "swap"},
+	})
+
+	if results[0].Data != "new" {
+		t.Errorf("Data = %v, want 'new'", results[0].Data)
+	}
+
+	infos := orch.ListRecipes()
+	if len(infos) != 1 || infos[0].Description != "swappable" {
+		t.Error("ReplaceRecipe did not preserve recipe options")
+	}
+}
+
+func TestReplaceRecipe_NotRegistered(t *testing.T) {
+	orch := New()
+
+	err := orch.ReplaceRecipe("missing", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	if err == nil {
+		t.Error("ReplaceRecipe should fail for unknown recipe")
+	}
+}
+
+func TestReplaceRecipe_NilHandlerPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("ReplaceRecipe with nil handler should panic")
+		}
+	}()
+
+	orch := New()
+	orch.ReplaceRecipe("any", nil)
+}
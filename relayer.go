@@ -2,13 +2,21 @@ package relayer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// panicError is a sentinel error type to distinguish panics from regular errors
-type panicError struct{}
+// panicError is a sentinel error type to distinguish panics from regular errors.
+// The panic value and stack are carried for WithDebugErrors; the message
+// stays generic so nothing sensitive leaks by default.
+type panicError struct {
+	value interface{} // Recovered panic value (nil unless captured)
+	stack []byte      // Stack captured at the panic site (nil unless captured)
+}
 
 func (e *panicError) Error() string {
 	return "internal error during recipe execution"
@@ -18,15 +26,78 @@ func (e *panicError) Error() string {
 // It provides concurrent request processing with tenant isolation,
 // panic recovery, and observability hooks.
 type Orchestrator struct {
-	registry       map[string]Handler
-	recipeOptions  map[string]*RecipeOption
-	mu             sync.RWMutex
-	timeout        time.Duration
-	executionHook  ExecutionHook
-	panicHook      PanicHook
-	maxConcurrency int
-	maxBatchSize   int           // Maximum batch size (0 = unlimited)
-	semaphore      chan struct{} // For concurrency limiting
+	registry           map[string]Handler
+	recipeOptions      map[string]*RecipeOption
+	registeredAt       map[string]time.Time
+	recipeSemaphores   map[string]chan struct{} // Per-recipe concurrency limits
+	mu                 sync.RWMutex
+	timeout            time.Duration
+	executionHook      ExecutionHook
+	panicHook          PanicHook
+	maxConcurrency     int
+	maxBatchSize       int                      // Maximum batch size (0 = unlimited)
+	semaphore          *fairSemaphore           // FIFO-fair concurrency limiting
+	queueHook          QueueHook                // Observes semaphore waits (nil = disabled)
+	errorHook          ErrorHook                // Observes failed requests only (nil = disabled)
+	admissionTimeout   time.Duration            // How long TryExecuteBatch waits for a slot
+	timeoutBudget      bool                     // Divide caller deadline across retry attempts
+	lateResults        LateResultHook           // Receives detached handlers' eventual results (nil = disabled)
+	supervised         bool                     // Supervise every handler goroutine (release slots at deadline)
+	pprofLabels        bool                     // Tag handler goroutines with pprof labels
+	debug              debugCounters            // Always-on counters for expvar/debug endpoints
+	authHook           AuthHook                 // Authorizes requests before execution (nil = disabled)
+	acl                ACLProvider              // Per-tenant recipe entitlements (nil = all allowed)
+	auditHook          AuditHook                // Observes authorization denials (nil = disabled)
+	redactor           Redactor                 // Scrubs payload copies handed to hooks (nil = masking only)
+	debugErrors        bool                     // Expose panic values, stacks, and error chains in Error.Details
+	validator          RequestValidator         // Custom per-request validation (nil = built-in checks only)
+	rejectDuplicateIDs bool                     // Fail repeated request IDs within a batch with 400
+	autoID             IDGenerator              // Fills empty request IDs (nil = reject with 400)
+	tenantConfigs      TenantConfigProvider     // Per-tenant limit overrides (nil = uniform limits)
+	tenantSemaphores   map[string]chan struct{} // Per-tenant concurrency caps, created on first use
+	usage              *usageAccountant         // Per-tenant usage accounting (nil = disabled)
+	usageHook          UsageHook                // Periodic usage flush target (nil = query-only)
+	usageFlushEvery    time.Duration            // Interval between usage flushes
+	quota              QuotaProvider            // Per-tenant request quotas (nil = unmetered)
+	distributed        DistributedLimiter       // Fleet-wide concurrency bound (nil = local only)
+	mirror             Executor                 // Shadow traffic target (nil = disabled)
+	mirrorPercent      float64                  // Fraction of requests mirrored, 0-100
+	mirrorDiffs        MirrorDiffHook           // Receives primary/shadow diffs (nil = replay only)
+	leaked             atomic.Int64             // Detached handlers still running past their deadline
+	defaultBudget      *Budget                  // Default per-request resource budget (nil = none)
+
+	adaptiveTimeouts *AdaptiveTimeoutConfig // Timeout auto-tuning (nil = disabled)
+	latencies        *latencyTracker        // Per-recipe latency history (nil = disabled)
+	shedder          *loadShedder           // Latency-based load shedding (nil = disabled)
+	watermark        *memoryWatermark       // Heap-based batch admission control (nil = disabled)
+	faults           *faultInjector         // Probabilistic fault injection (nil = disabled)
+	chaos            *chaosInjector         // Targeted chaos injection (nil = disabled)
+	scheduler        Scheduler              // Work dispatch strategy (default: goroutine per task)
+	rateLimiter      *tenantRateLimiter     // Per-tenant rate limiting (nil = disabled)
+	dedupKey         DedupKeyFunc           // In-batch request coalescing (nil = disabled)
+	singleflight     *singleflightGroup     // Cross-batch request coalescing (nil = disabled)
+	resultCache      Cache                  // Result cache for idempotent recipes (nil = disabled)
+	resultCacheTTL   time.Duration          // TTL for cached results
+	jobStore         JobStore               // Async batch persistence (nil = async API disabled)
+	resultStore      ResultStore            // Completed-result persistence (nil = disabled)
+	recorder         *recorder              // NDJSON traffic recording (nil = disabled)
+	deadLetters      DeadLetterSink         // Sink for permanently failed requests (nil = disabled)
+	middleware       []Middleware           // Global handler middleware chain
+	stats            *StatsCollector        // Built-in statistics (nil = disabled)
+	timestamps       bool                   // Populate Response.StartedAt/CompletedAt
+	clock            Clock                  // Time source for durations and timeouts
+	progress         *progressRegistry      // Live progress of async batches
+	progressHook     ProgressHook           // Per-completion progress callback (nil = disabled)
+
+	active *inflightTracker // Currently executing requests, for InFlight
+
+	defaultRecipe Handler // Catch-all for unknown recipe names (nil = 404)
+
+	// Lifecycle state for graceful shutdown.
+	closed         atomic.Bool        // Set once Shutdown begins; new batches are refused
+	inflight       inflightGauge      // Tracks requests currently executing
+	shutdownCtx    context.Context    // Cancelled to abort straggling requests
+	shutdownCancel context.CancelFunc // Cancels shutdownCtx
 }
 
 // New creates a new Orchestrator with the provided options.
@@ -44,12 +115,18 @@ type Orchestrator struct {
 //	)
 func New(opts ...Option) *Orchestrator {
 	o := &Orchestrator{
-		registry:       make(map[string]Handler),
-		recipeOptions:  make(map[string]*RecipeOption),
-		timeout:        5 * time.Second, // Default timeout
-		executionHook:  &NoOpHook{},
-		panicHook:      &NoOpHook{},
-		maxConcurrency: 0, // Unlimited by default
+		registry:         make(map[string]Handler),
+		recipeOptions:    make(map[string]*RecipeOption),
+		registeredAt:     make(map[string]time.Time),
+		recipeSemaphores: make(map[string]chan struct{}),
+		timeout:          5 * time.Second, // Default timeout
+		executionHook:    &NoOpHook{},
+		panicHook:        &NoOpHook{},
+		maxConcurrency:   0, // Unlimited by default
+		scheduler:        goroutineScheduler{},
+		active:           newInflightTracker(),
+		clock:            realClock{},
+		progress:         newProgressRegistry(),
 	}
 
 	for _, opt := range opts {
@@ -58,7 +135,23 @@ func New(opts ...Option) *Orchestrator {
 
 	// Initialize semaphore if concurrency limiting is enabled
 	if o.maxConcurrency > 0 {
-		o.semaphore = make(chan struct{}, o.maxConcurrency)
+		o.semaphore = newFairSemaphore(o.maxConcurrency)
+	}
+
+	// Tenant config overrides need somewhere to enforce rate limits and
+	// concurrency caps even when the uniform options are unset
+	if o.tenantConfigs != nil {
+		o.tenantSemaphores = make(map[string]chan struct{})
+		if o.rateLimiter == nil {
+			o.rateLimiter = &tenantRateLimiter{buckets: make(map[string]*tokenBucket)}
+		}
+	}
+
+	o.shutdownCtx, o.shutdownCancel = context.WithCancel(context.Background())
+
+	// Start the usage flusher once shutdownCtx exists to stop it
+	if o.usageHook != nil {
+		go o.flushUsage()
 	}
 
 	return o
@@ -97,8 +190,10 @@ func RegisterRecipe(o *Orchestrator, name string, handler Handler, opts ...*Reci
 	defer o.mu.Unlock()
 
 	o.registry[name] = handler
+	o.registeredAt[name] = time.Now()
 	if len(opts) > 0 && opts[0] != nil {
 		o.recipeOptions[name] = opts[0]
+		o.setRecipeSemaphoreLocked(name, opts[0])
 	}
 }
 
@@ -137,10 +232,188 @@ func (o *Orchestrator) RegisterRecipeStrict(name string, handler Handler, opts .
 	}
 
 	o.registry[name] = handler
+	o.registeredAt[name] = time.Now()
 	if len(opts) > 0 && opts[0] != nil {
 		o.recipeOptions[name] = opts[0]
+		o.setRecipeSemaphoreLocked(name, opts[0])
+	}
+
+	return nil
+}
+
+// setRecipeSemaphoreLocked creates or removes the recipe's concurrency
+// semaphore based on its options. Caller must hold o.mu.
+func (o *Orchestrator) setRecipeSemaphoreLocked(name string, opt *RecipeOption) {
+	if opt.MaxConcurrency < 0 {
+		panic("recipe max concurrency must be non-negative")
+	}
+	if opt.MaxConcurrency > 0 {
+		o.recipeSemaphores[name] = make(chan struct{}, opt.MaxConcurrency)
+	} else {
+		delete(o.recipeSemaphores, name)
+	}
+}
+
+// recipeSemaphore returns the recipe's concurrency semaphore, or nil if
+// the recipe declares no limit.
+func (o *Orchestrator) recipeSemaphore(name string) chan struct{} {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.recipeSemaphores[name]
+}
+
+// UnregisterRecipe removes a recipe from the registry along with its
+// options and metadata. Returns true if the recipe existed. In-flight
+// executions of the recipe are unaffected; only new requests see the
+// removal. Useful for feature-flagged recipes and plugin hot-reload.
+//
+// Example:
+//
+//	if orch.UnregisterRecipe("legacy-lookup") {
+//	    log.Println("legacy-lookup disabled")
+//	}
+func (o *Orchestrator) UnregisterRecipe(name string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, exists := o.registry[name]; !exists {
+		return false
+	}
+
+	delete(o.registry, name)
+	delete(o.recipeOptions, name)
+	delete(o.registeredAt, name)
+	delete(o.recipeSemaphores, name)
+	return true
+}
+
+// ReplaceRecipe atomically swaps the handler for an already-registered
+// recipe, preserving its options and registration time. Returns an error
+// if the recipe does not exist — use RegisterRecipe to add new recipes.
+// Panics if handler is nil, matching RegisterRecipe's validation.
+//
+// Example:
+//
+//	if err := orch.ReplaceRecipe("get-user", newHandler); err != nil {
+//	    log.Printf("hot-reload failed: %v", err)
+//	}
+func (o *Orchestrator) ReplaceRecipe(name string, handler Handler) error {
+	if handler == nil {
+		panic("recipe handler cannot be nil")
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, exists := o.registry[name]; !exists {
+		return fmt.Errorf("recipe '%s' is not registered", name)
 	}
 
+	o.registry[name] = handler
+	return nil
+}
+
+// RegisterDefaultRecipe installs a catch-all handler invoked for any
+// request whose recipe name is not registered, instead of returning a
+// 404. The requested name is available inside the handler via
+// RecipeName(ctx), so proxy-style deployments can forward unknown
+// recipes to a legacy system. Panics if handler is nil.
+//
+// Example:
+//
+//	orch.RegisterDefaultRecipe(func(ctx context.Context, payload interface{}) (interface{}, error) {
+//		name, _ := relayer.RecipeName(ctx)
+//		return legacyClient.Call(ctx, name, payload)
+//	})
+func (o *Orchestrator) RegisterDefaultRecipe(handler Handler) {
+	if handler == nil {
+		panic("recipe handler cannot be nil")
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.defaultRecipe = handler
+}
+
+// SwapRegistry atomically replaces the entire recipe set. The map is
+// copied on the way in, so callers can keep mutating their own copy.
+// Recipes absent from the new set are dropped along with their options
+// and metadata; recipes that keep their name retain their options and
+// original registration time. There is no window where a recipe from
+// either set is missing, which makes this safe for config-driven
+// deployments that rebuild the full set on reload. Panics if any name
+// is empty or any handler is nil.
+//
+// Example:
+//
+//	recipes := buildRecipesFromConfig(cfg)
+//	orch.SwapRegistry(recipes)
+func (o *Orchestrator) SwapRegistry(newRecipes map[string]Handler) {
+	registry := make(map[string]Handler, len(newRecipes))
+	for name, handler := range newRecipes {
+		if name == "" {
+			panic("recipe name cannot be empty")
+		}
+		if handler == nil {
+			panic("recipe handler cannot be nil")
+		}
+		registry[name] = handler
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	registeredAt := make(map[string]time.Time, len(registry))
+	options := make(map[string]*RecipeOption)
+	semaphores := make(map[string]chan struct{})
+	for name := range registry {
+		if at, ok := o.registeredAt[name]; ok {
+			registeredAt[name] = at
+		} else {
+			registeredAt[name] = time.Now()
+		}
+		if opt, ok := o.recipeOptions[name]; ok {
+			options[name] = opt
+		}
+		if sem, ok := o.recipeSemaphores[name]; ok {
+			semaphores[name] = sem
+		}
+	}
+
+	o.registry = registry
+	o.registeredAt = registeredAt
+	o.recipeOptions = options
+	o.recipeSemaphores = semaphores
+}
+
+// SetMaxBatchSize adjusts the batch size limit at runtime. 0 removes
+// the limit. Panics if max is negative, matching WithMaxBatchSize.
+func (o *Orchestrator) SetMaxBatchSize(max int) {
+	if max < 0 {
+		panic("max batch size must be non-negative")
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.maxBatchSize = max
+}
+
+// SetMaxConcurrency resizes the concurrency limit at runtime. Growing
+// admits queued requests immediately; shrinking takes effect as
+// in-flight requests complete. Returns an error if the orchestrator was
+// created without WithMaxConcurrency — limiting cannot be turned on
+// after construction. Panics if max is <= 0.
+func (o *Orchestrator) SetMaxConcurrency(max int) error {
+	if max <= 0 {
+		panic("max concurrency must be positive")
+	}
+	if o.semaphore == nil {
+		return fmt.Errorf("concurrency limiting not enabled; create the orchestrator with WithMaxConcurrency")
+	}
+
+	o.mu.Lock()
+	o.maxConcurrency = max
+	o.mu.Unlock()
+	o.semaphore.resize(max)
 	return nil
 }
 
@@ -160,8 +433,116 @@ func (o *Orchestrator) RegisterRecipeStrict(name string, handler Handler, opts .
 //	results := orch.ExecuteBatch(ctx, batch)
 //	successes := relayer.FilterSuccess(results)
 func (o *Orchestrator) ExecuteBatch(ctx context.Context, batch []SubRequest) []Response {
-	// Check batch size limit
-	if o.maxBatchSize > 0 && len(batch) > o.maxBatchSize {
+	if rejected, ok := o.admitBatch(batch); !ok {
+		return rejected
+	}
+
+	// Every sub-request of this batch shares one batch ID (generated
+	// here unless the caller set one via WithBatchID).
+	ctx = ensureBatchID(ctx)
+
+	// Reject duplicate request IDs when configured; the first
+	// occurrence still executes
+	if o.rejectDuplicateIDs && len(batch) > 1 {
+		return o.executeBatchRejectDups(ctx, batch)
+	}
+
+	// Coalesce duplicate requests when deduplication is enabled
+	if o.dedupKey != nil && len(batch) > 1 {
+		return o.executeBatchDeduped(ctx, batch)
+	}
+
+	results := make([]Response, len(batch))
+
+	// Fast path: a single-request batch is executed inline on the caller's
+	// goroutine. Transports frequently forward singleton batches, and they
+	// shouldn't pay for a WaitGroup and goroutine handoff.
+	if len(batch) == 1 {
+		o.processRequest(ctx, batch[0], &results[0])
+		return results
+	}
+
+	o.dispatch(ctx, batch, results)
+	return results
+}
+
+// dispatch schedules the batch's requests and blocks until all have
+// completed. Requests sharing a non-empty SerializationKey are chained
+// onto one task that runs them sequentially in input order; everything
+// else gets its own task.
+func (o *Orchestrator) dispatch(ctx context.Context, batch []SubRequest, results []Response) {
+	var wg sync.WaitGroup
+
+	// Indices grouped by serialization key, preserving input order.
+	var keyed map[string][]int
+	for i, req := range batch {
+		if req.SerializationKey == "" {
+			continue
+		}
+		if keyed == nil {
+			keyed = make(map[string][]int)
+		}
+		keyed[req.SerializationKey] = append(keyed[req.SerializationKey], i)
+	}
+
+	for i, req := range batch {
+		if req.SerializationKey != "" {
+			continue
+		}
+		wg.Add(1)
+		req := req
+		result := &results[i]
+		o.scheduler.Schedule(func() {
+			o.executeRequest(ctx, &wg, req, result)
+		})
+	}
+
+	for _, indices := range keyed {
+		wg.Add(1)
+		indices := indices
+		o.scheduler.Schedule(func() {
+			defer wg.Done()
+			for _, i := range indices {
+				o.processRequest(ctx, batch[i], &results[i])
+			}
+		})
+	}
+
+	wg.Wait()
+}
+
+// admitBatch runs batch-level admission checks (memory watermark, size
+// limit). On rejection it returns the error responses and false.
+func (o *Orchestrator) admitBatch(batch []SubRequest) ([]Response, bool) {
+	// Refuse new batches once shutdown has begun
+	if o.closed.Load() {
+		results := make([]Response, len(batch))
+		for i, req := range batch {
+			results[i] = Response{
+				ID:       req.ID,
+				Status:   503,
+				TenantID: req.TenantID,
+				Error: &Error{
+					Code:    ErrCodeShuttingDown,
+					Message: "orchestrator is shutting down",
+				},
+			}
+		}
+		return results, false
+	}
+
+	// Check memory watermark before admitting the batch
+	if o.watermark != nil && len(batch) > 0 {
+		if over, heap := o.watermark.exceeded(); over {
+			return o.watermark.rejectBatch(batch, heap), false
+		}
+	}
+
+	// Check batch size limit (adjustable at runtime via SetMaxBatchSize)
+	o.mu.RLock()
+	maxBatchSize := o.maxBatchSize
+	o.mu.RUnlock()
+	if maxBatchSize > 0 && len(batch) > maxBatchSize {
 		// Return error response for all requests in oversized batch
 		results := make([]Response, len(batch))
 		for i, req := range batch {
@@ -171,60 +552,132 @@ func (o *Orchestrator) ExecuteBatch(ctx context.Context, batch []SubRequest) []R
 				TenantID: req.TenantID,
 				Error: &Error{
 					Code:    ErrCodeBatchTooLarge,
-					Message: fmt.Sprintf("batch size %d exceeds limit of %d", len(batch), o.maxBatchSize),
+					Message: fmt.Sprintf("batch size %d exceeds limit of %d", len(batch), maxBatchSize),
 				},
 			}
 		}
-		return results
+		return results, false
 	}
 
-	results := make([]Response, len(batch))
-	var wg sync.WaitGroup
+	return nil, true
+}
 
-	for i, req := range batch {
+// ExecuteBatchStream processes a batch concurrently and emits each
+// Response on the returned channel as soon as its recipe finishes,
+// instead of waiting for the whole batch. The channel is closed once all
+// requests have completed. Responses arrive in completion order, not
+// input order; use Response.ID to correlate.
+//
+// Example:
+//
+//	for resp := range orch.ExecuteBatchStream(ctx, batch) {
+//		fmt.Printf("request %s finished with %d\n", resp.ID, resp.Status)
+//	}
+func (o *Orchestrator) ExecuteBatchStream(ctx context.Context, batch []SubRequest) <-chan Response {
+	// Buffer for the whole batch so slow consumers never block workers.
+	out := make(chan Response, len(batch))
+
+	if rejected, ok := o.admitBatch(batch); !ok {
+		for _, resp := range rejected {
+			out <- resp
+		}
+		close(out)
+		return out
+	}
+
+	ctx = ensureBatchID(ctx)
+
+	var wg sync.WaitGroup
+	for _, req := range batch {
 		wg.Add(1)
-		go o.executeRequest(ctx, &wg, req, &results[i])
+		req := req
+		o.scheduler.Schedule(func() {
+			defer wg.Done()
+			var resp Response
+			o.processRequest(ctx, req, &resp)
+			out <- resp
+		})
 	}
 
-	wg.Wait()
-	return results
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
 }
 
 // executeRequest processes a single request in a goroutine.
-// It handles concurrency limiting, context enrichment, timeout, and hooks.
 func (o *Orchestrator) executeRequest(ctx context.Context, wg *sync.WaitGroup, req SubRequest, result *Response) {
 	defer wg.Done()
+	o.processRequest(ctx, req, result)
+}
 
-	// Acquire semaphore if concurrency limiting is enabled
-	if o.maxConcurrency > 0 {
-		select {
-		case o.semaphore <- struct{}{}:
-			defer func() { <-o.semaphore }()
-		case <-ctx.Done():
-			// Context cancelled while waiting for execution slot
-			*result = Response{
-				ID:       req.ID,
-				Status:   504,
-				TenantID: req.TenantID,
-				Duration: time.Since(time.Now()),
-				Error: &Error{
-					Code:    ErrCodeTimeout,
-					Message: "request cancelled while waiting for execution slot",
-				},
-			}
+// processRequest processes a single request on the current goroutine.
+// It handles concurrency limiting, context enrichment, timeout, and hooks.
+func (o *Orchestrator) processRequest(ctx context.Context, req SubRequest, result *Response) {
+	o.inflight.add()
+	defer o.inflight.done()
+
+	// Fill empty request IDs when auto-generation is enabled, before
+	// the request becomes visible to tracking and validation
+	if req.ID == "" && o.autoID != nil {
+		req.ID = o.autoID()
+	}
+
+	token := o.active.add(req)
+	defer o.active.remove(token)
+
+	// Every request carries a trace ID through hooks, context, and the
+	// response; generate one when the caller didn't supply it. The batch
+	// ID stamped by the batch entry point is echoed the same way.
+	if req.TraceID == "" {
+		req.TraceID = newTraceID()
+	}
+	batchID, _ := BatchID(ctx)
+	defer func() {
+		result.TraceID = req.TraceID
+		result.BatchID = batchID
+	}()
+
+	// Authorize before any execution resources (cache, rate limit
+	// tokens, slots) are consumed
+	if o.authHook != nil {
+		if err := o.authHook.Authorize(ctx, req); err != nil {
+			*result = authFailureResponse(req, err)
+			o.auditDenied(ctx, req, *result)
 			return
 		}
 	}
 
-	start := time.Now()
+	// Per-tenant configuration overrides, consulted once per request
+	var tenantCfg TenantConfig
+	hasTenantCfg := false
+	if o.tenantConfigs != nil && req.TenantID != "" {
+		tenantCfg, hasTenantCfg = o.tenantConfigs.TenantConfig(req.TenantID)
+	}
+
+	// Per-tenant recipe entitlements
+	if o.acl != nil && !o.acl.Allowed(req.TenantID, req.Recipe) {
+		*result = aclDeniedResponse(req)
+		o.auditDenied(ctx, req, *result)
+		return
+	}
+	if hasTenantCfg && !tenantCfg.recipeAllowed(req.Recipe) {
+		*result = aclDeniedResponse(req)
+		o.auditDenied(ctx, req, *result)
+		return
+	}
 
-	// Validate request fields
+	// Validate request fields. Validation is a pure function of the
+	// request, so it runs before rate-limit tokens, quota units, cache
+	// lookups, or execution slots are spent on a request that can never
+	// execute.
 	if req.ID == "" || req.TenantID == "" || req.Recipe == "" {
 		*result = Response{
 			ID:       req.ID,
 			Status:   400,
 			TenantID: req.TenantID,
-			Duration: time.Since(start),
 			Error: &Error{
 				Code:    ErrCodeInvalidRequest,
 				Message: "request must have non-empty ID, TenantID, and Recipe",
@@ -233,44 +686,338 @@ func (o *Orchestrator) executeRequest(ctx context.Context, wg *sync.WaitGroup, r
 		return
 	}
 
+	// Custom validation runs after the built-in field checks
+	if o.validator != nil {
+		if verr := o.validator(req); verr != nil {
+			*result = Response{
+				ID:       req.ID,
+				Status:   400,
+				TenantID: req.TenantID,
+				Error:    verr,
+			}
+			return
+		}
+	}
+
+	// Shed low-priority work before it consumes an execution slot
+	if o.shedder != nil {
+		if shed, reason := o.shedder.shouldShed(req.Priority); shed {
+			*result = o.shedder.shedResponse(req, reason)
+			return
+		}
+	}
+
+	// Enforce per-tenant rate limits before consuming an execution slot
+	if o.rateLimiter != nil && req.TenantID != "" {
+		rps, burst := o.rateLimiter.rps, o.rateLimiter.burst
+		if hasTenantCfg && tenantCfg.RateLimitRPS > 0 {
+			rps = tenantCfg.RateLimitRPS
+			burst = float64(tenantCfg.RateLimitBurst)
+			if burst < 1 {
+				burst = 1
+			}
+		}
+		if ok, retryAfter := o.rateLimiter.allowRate(req.TenantID, rps, burst); !ok {
+			*result = o.rateLimiter.limitResponse(req, retryAfter)
+			return
+		}
+	}
+
+	// Enforce per-tenant quotas. A provider error admits the request:
+	// quota backend outages shouldn't take execution down with them
+	if o.quota != nil && req.TenantID != "" {
+		if decision, err := o.quota.Consume(ctx, req.TenantID); err == nil && !decision.Allowed {
+			*result = quotaExceededResponse(req, decision)
+			return
+		}
+	}
+
+	// Serve from the result cache before consuming an execution slot
+	var cacheKey string
+	if o.resultCache != nil && req.ID != "" && req.TenantID != "" && req.Recipe != "" {
+		cacheKey = DefaultDedupKey(req)
+		if cached, hit := o.resultCache.Get(cacheKey); hit {
+			cached.ID = req.ID
+			cached.TenantID = req.TenantID
+			*result = cached
+			return
+		}
+	}
+
+	// Acquire semaphore if concurrency limiting is enabled. Waiters are
+	// served in arrival order. The wait is reported separately from
+	// execution time via Response.QueueDuration.
+	var queueWait time.Duration
+	if o.semaphore != nil {
+		wait, err := o.semaphore.acquire(ctx)
+		if err != nil {
+			// Context cancelled while waiting for execution slot
+			*result = Response{
+				ID:       req.ID,
+				Status:   504,
+				TenantID: req.TenantID,
+				Error: &Error{
+					Code:    ErrCodeTimeout,
+					Message: "request cancelled while waiting for execution slot",
+				},
+			}
+			return
+		}
+		defer o.semaphore.release()
+		queueWait = wait
+
+		if o.shedder != nil {
+			o.shedder.recordQueueWait(wait)
+		}
+		if o.queueHook != nil {
+			o.queueHook.OnQueueWait(ctx, o.redactRequest(req), wait, o.semaphore.depth())
+		}
+	}
+
+	// Acquire the fleet-wide slot after the local one, so this replica
+	// never holds shared capacity for locally queued requests. Limiter
+	// errors other than the context's fail open to the local bound.
+	if o.distributed != nil {
+		release, err := o.distributed.Acquire(ctx)
+		switch {
+		case err == nil:
+			defer release()
+		case errors.Is(err, ctx.Err()):
+			*result = Response{
+				ID:       req.ID,
+				Status:   504,
+				TenantID: req.TenantID,
+				Error: &Error{
+					Code:    ErrCodeTimeout,
+					Message: "request cancelled while waiting for distributed slot",
+				},
+			}
+			return
+		}
+	}
+
+	// Acquire the per-recipe semaphore when the recipe declares its own
+	// concurrency limit
+	if sem := o.recipeSemaphore(req.Recipe); sem != nil {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+		case <-ctx.Done():
+			*result = Response{
+				ID:       req.ID,
+				Status:   504,
+				TenantID: req.TenantID,
+				Error: &Error{
+					Code:    ErrCodeTimeout,
+					Message: "request cancelled while waiting for recipe slot",
+				},
+			}
+			return
+		}
+	}
+
+	// Acquire the tenant's semaphore when its config caps concurrency
+	if hasTenantCfg {
+		if sem := o.tenantSemaphore(req.TenantID, tenantCfg.MaxConcurrency); sem != nil {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				*result = Response{
+					ID:       req.ID,
+					Status:   504,
+					TenantID: req.TenantID,
+					Error: &Error{
+						Code:    ErrCodeTimeout,
+						Message: "request cancelled while waiting for tenant slot",
+					},
+				}
+				return
+			}
+		}
+	}
+
+	start := o.clock.Now()
+
+	// Collect execution annotations (e.g. conditional branches taken)
+	ctx, meta := withResponseMeta(ctx)
+
 	// Enrich context with request metadata
-	taskCtx := WithTenantID(ctx, req.TenantID)
-	taskCtx = WithRequestID(taskCtx, req.ID)
-	taskCtx = WithRecipeName(taskCtx, req.Recipe)
+	taskCtx := WithRequestInfo(ctx, RequestInfo{
+		TenantID:   req.TenantID,
+		RequestID:  req.ID,
+		RecipeName: req.Recipe,
+		TraceID:    req.TraceID,
+		BatchID:    batchID,
+	})
 
 	// Get recipe timeout (check for per-recipe override)
 	timeout := o.timeout
+	explicit := false
+	timeoutSource := "default"
 	o.mu.RLock()
 	if recipeOpt, exists := o.recipeOptions[req.Recipe]; exists && recipeOpt.Timeout > 0 {
 		timeout = recipeOpt.Timeout
+		explicit = true
+		timeoutSource = "recipe"
 	}
 	o.mu.RUnlock()
 
-	// Apply timeout
-	taskCtx, cancel := context.WithTimeout(taskCtx, timeout)
+	// A tenant's configured timeout beats the recipe and default ones
+	if hasTenantCfg && tenantCfg.Timeout > 0 {
+		timeout = tenantCfg.Timeout
+		explicit = true
+		timeoutSource = "tenant"
+	}
+
+	// Adaptive timeouts apply only when no explicit per-recipe timeout is set
+	if !explicit {
+		if adaptive, ok := o.adaptiveTimeout(req.Recipe); ok {
+			timeout = adaptive
+			timeoutSource = "adaptive"
+		}
+	}
+
+	// A caller deadline tighter than the recipe timeout is the binding
+	// constraint; remember it so the resulting timeout is reported as the
+	// client's rather than ours.
+	clientDeadline, clientConstrained := taskCtx.Deadline()
+	if clientConstrained {
+		clientConstrained = clientDeadline.Before(o.clock.Now().Add(timeout))
+	}
+
+	// Apply timeout via the configured clock
+	taskCtx, cancel := o.withTimeout(taskCtx, timeout)
 	defer cancel()
 
-	// Execute with hooks and panic recovery
-	o.executionHook.OnStart(taskCtx, req)
+	// Expose the cancel to admin tooling (CancelRequest)
+	o.active.setCancel(token, cancel)
 
-	resp := o.safeExecute(taskCtx, req)
-	resp.Duration = time.Since(start)
+	// Cancel stragglers once Shutdown gives up waiting
+	stop := context.AfterFunc(o.shutdownCtx, cancel)
+	defer stop()
+
+	// Execute with hooks and panic recovery. Hooks receive a redacted
+	// payload copy; the handler sees the original.
+	hookReq := o.redactRequest(req)
+	o.executionHook.OnStart(taskCtx, hookReq)
+
+	resp, rawErr := o.executeWithRetries(taskCtx, req)
+	if clientConstrained && resp.Error != nil && resp.Error.Code == ErrCodeTimeout {
+		resp.Error.Code = ErrCodeClientTimeout
+		resp.Error.Message = fmt.Sprintf("caller deadline expired before recipe '%s' completed", req.Recipe)
+	}
+	resp.Duration = o.clock.Now().Sub(start)
 	resp.TenantID = req.TenantID
+	resp.BatchID = batchID
+	resp.TraceID = req.TraceID
+	resp.QueueDuration = queueWait
+
+	// Timeout responses carry enough detail to tell a slow handler from
+	// queueing overload or a tight caller deadline.
+	if resp.Error != nil && (resp.Error.Code == ErrCodeTimeout || resp.Error.Code == ErrCodeClientTimeout) {
+		source := timeoutSource
+		if resp.Error.Code == ErrCodeClientTimeout {
+			source = "client"
+		}
+		if resp.Error.Details == nil {
+			resp.Error.Details = make(map[string]interface{}, 4)
+		}
+		resp.Error.Details["timeout_source"] = source
+		resp.Error.Details["timeout_ms"] = float64(timeout) / float64(time.Millisecond)
+		resp.Error.Details["elapsed_ms"] = float64(resp.Duration) / float64(time.Millisecond)
+		resp.Error.Details["queue_wait_ms"] = float64(queueWait) / float64(time.Millisecond)
+	}
+	meta.apply(&resp)
+	resp.Error = redactError(resp.Error)
+	if o.timestamps {
+		completed := start.Add(resp.Duration)
+		resp.StartedAt = &start
+		resp.CompletedAt = &completed
+	}
 
-	o.executionHook.OnComplete(taskCtx, req, resp, resp.Duration)
+	// Record latency history for completed executions. Timed-out samples
+	// are excluded: they are capped at the current timeout and would drag
+	// the observed p99 toward it.
+	if o.latencies != nil && (resp.Error == nil || (resp.Error.Code != ErrCodeTimeout && resp.Error.Code != ErrCodeClientTimeout)) {
+		o.latencies.record(req.Recipe, resp.Duration)
+	}
+	if o.shedder != nil {
+		o.shedder.recordLatency(resp.Duration)
+	}
+	failed := resp.Status < 200 || resp.Status >= 300
+	o.debug.record(req.Recipe, failed)
+	if o.stats != nil {
+		o.stats.record(req.Recipe, req.TenantID, resp.Duration, failed)
+	}
+	if o.usage != nil {
+		o.usage.record(req.TenantID, resp.Duration, failed, o.clock.Now())
+	}
+
+	// Cache successful results for future identical requests
+	if o.resultCache != nil && cacheKey != "" && resp.Status >= 200 && resp.Status < 300 {
+		o.resultCache.Set(cacheKey, resp, o.resultCacheTTL)
+	}
+
+	// Dead-letter permanently failed requests (retries already exhausted
+	// by executeWithRetries). Best-effort: publish errors are ignored.
+	if o.deadLetters != nil && resp.Status >= 500 {
+		o.deadLetters.Publish(DeadLetter{
+			Request:  req,
+			Response: resp,
+			FailedAt: time.Now(),
+		})
+	}
+
+	// Persist the result for later audit queries. Best-effort: store
+	// errors are ignored.
+	if o.resultStore != nil {
+		o.resultStore.Save(&ResultRecord{
+			BatchID:     batchID,
+			Recipe:      req.Recipe,
+			Response:    resp,
+			CompletedAt: o.clock.Now(),
+		})
+	}
+
+	// Record the exchange for later replay. Like dead letters, the
+	// original (unredacted) request is kept so it can be re-executed.
+	if o.recorder != nil {
+		o.recorder.record(req, resp, o.clock.Now())
+	}
+
+	// Failures additionally reach the error hook, with the raw handler
+	// error alongside the sanitized one.
+	if o.errorHook != nil && resp.Error != nil {
+		o.errorHook.OnError(taskCtx, hookReq, resp.Error, rawErr)
+	}
+
+	o.executionHook.OnComplete(taskCtx, hookReq, resp, resp.Duration)
+
+	// Replay the sampled fraction to the shadow executor, off the
+	// request path so primary latency is unaffected
+	if o.mirror != nil && o.mirrorSampled() {
+		go o.mirrorRequest(ctx, req, resp)
+	}
 
 	*result = resp
 }
 
 // safeExecute executes the recipe with panic recovery.
-// Returns a Response with appropriate status code and error information.
-func (o *Orchestrator) safeExecute(ctx context.Context, req SubRequest) Response {
-	// Look up handler
+// Returns a Response with appropriate status code and error information,
+// plus the raw handler error (nil on success; *panicError after a panic)
+// so retry policies can inspect it.
+func (o *Orchestrator) safeExecute(ctx context.Context, req SubRequest) (Response, error) {
+	// Look up handler, falling back to the catch-all recipe when one is
+	// registered (the requested name is available via RecipeName(ctx)).
 	o.mu.RLock()
 	handler, exists := o.registry[req.Recipe]
+	if !exists {
+		handler = o.defaultRecipe
+	}
 	o.mu.RUnlock()
 
-	if !exists {
+	if handler == nil {
 		return Response{
 			ID:     req.ID,
 			Status: 404,
@@ -278,50 +1025,110 @@ func (o *Orchestrator) safeExecute(ctx context.Context, req SubRequest) Response
 				Code:    ErrCodeRecipeNotFound,
 				Message: fmt.Sprintf("recipe '%s' not found", req.Recipe),
 			},
-		}
+		}, nil
 	}
 
-	// Execute handler with panic recovery
-	var data interface{}
-	var err error
+	// Apply the middleware chain (global outermost, per-recipe innermost)
+	handler = o.applyMiddleware(req.Recipe, handler)
+
+	// Apply fault injection when explicitly enabled
+	if o.faults != nil {
+		handler = o.faults.wrap(handler)
+	}
+
+	// Apply targeted chaos injection when the request is in scope
+	if o.chaos != nil {
+		handler = o.chaos.wrap(req, handler)
+	}
+
+	// Collapse identical in-flight executions when singleflight is enabled
+	if o.singleflight != nil {
+		handler = o.singleflight.wrap(req, handler)
+	}
 
-	func() {
+	// Tag the handler goroutine for profiling when enabled
+	if o.pprofLabels {
+		handler = withPprofLabels(req, handler)
+	}
+
+	// Execute handler with panic recovery
+	run := func() (data interface{}, err error) {
 		defer func() {
 			if r := recover(); r != nil {
-				// Call panic hook with full panic value for internal logging/alerting
-				// The hook can log the panic value and stack trace internally
-				o.panicHook.OnPanic(ctx, req, r)
-				// Set sentinel error (no sensitive information in message)
-				err = &panicError{}
+				// Call panic hook with full panic value for internal
+				// logging/alerting. The stack must be captured here, on
+				// the panicking goroutine.
+				stack := debug.Stack()
+				if sh, ok := o.panicHook.(PanicStackHook); ok {
+					sh.OnPanicWithStack(ctx, o.redactRequest(req), r, stack)
+				} else {
+					o.panicHook.OnPanic(ctx, o.redactRequest(req), r)
+				}
+				// Set sentinel error (no sensitive information in the
+				// message; the value and stack only surface under
+				// WithDebugErrors)
+				err = &panicError{value: r, stack: stack}
 			}
 		}()
-		data, err = handler(ctx, req.Payload)
-	}()
+		return handler(ctx, req.Payload)
+	}
+
+	if o.detachOnTimeout(req.Recipe) {
+		return o.executeDetached(ctx, req, run)
+	}
+
+	data, err := run()
 
 	// Handle timeout
-	if ctx.Err() == context.DeadlineExceeded {
-		return Response{
-			ID:     req.ID,
-			Status: 504,
-			Error: &Error{
-				Code:    ErrCodeTimeout,
-				Message: "recipe execution timed out",
-			},
-		}
+	if timedOut(ctx) {
+		return timeoutResponse(req), err
+	}
+
+	return o.buildResponse(req, data, err)
+}
+
+// timeoutResponse is the 504 returned when a request's deadline fires.
+func timeoutResponse(req SubRequest) Response {
+	return Response{
+		ID:     req.ID,
+		Status: 504,
+		Error: &Error{
+			Code:    ErrCodeTimeout,
+			Message: "recipe execution timed out",
+		},
 	}
+}
 
+// buildResponse converts a handler's return values into a Response,
+// classifying panics, payload type errors, and regular recipe errors.
+func (o *Orchestrator) buildResponse(req SubRequest, data interface{}, err error) (Response, error) {
 	// Handle execution error
 	if err != nil {
 		// Check if error is from a panic
-		if _, isPanic := err.(*panicError); isPanic {
+		if pe, isPanic := err.(*panicError); isPanic {
 			return Response{
 				ID:     req.ID,
 				Status: 500,
 				Error: &Error{
 					Code:    ErrCodePanic,
 					Message: err.Error(), // Generic message from panicError
+					Details: o.panicDebugDetails(pe),
 				},
-			}
+			}, err
+		}
+
+		// Payload conversion failures from typed handlers are the
+		// caller's fault, not the recipe's.
+		var typeErr *PayloadTypeError
+		if errors.As(err, &typeErr) {
+			return Response{
+				ID:     req.ID,
+				Status: 400,
+				Error: &Error{
+					Code:    ErrCodeInvalidPayload,
+					Message: err.Error(),
+				},
+			}, err
 		}
 
 		// Regular recipe error
@@ -331,13 +1138,25 @@ func (o *Orchestrator) safeExecute(ctx context.Context, req SubRequest) Response
 			Error: &Error{
 				Code:    ErrCodeRecipeExecution,
 				Message: err.Error(),
+				Details: o.errorDebugDetails(err),
 			},
+		}, err
+	}
+
+	// Handlers may return a *Response (or any Responder) to control
+	// status, error details, and data directly.
+	if responder, ok := asResponder(data); ok {
+		resp := responder.Response()
+		resp.ID = req.ID
+		if resp.Status == 0 {
+			resp.Status = 200
 		}
+		return resp, nil
 	}
 
 	return Response{
 		ID:     req.ID,
 		Status: 200,
 		Data:   data,
-	}
+	}, nil
 }
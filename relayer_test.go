@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -544,3 +545,50 @@ func TestExecuteBatch_ResponseDuration(t *testing.T) {
 		t.Errorf("Duration = %v, want >= 50ms", results[0].Duration)
 	}
 }
+
+func TestExecuteBatch_SingleRequestFastPath(t *testing.T) {
+	orch := New()
+
+	// Record the goroutine running the handler; a singleton batch should
+	// execute inline on the caller's goroutine.
+	callerID := goroutineID()
+	var handlerID uint64
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		handlerID = goroutineID()
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hello"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+
+	if results[0].Data != "hello" {
+		t.Errorf("Data = %v, want 'hello'", results[0].Data)
+	}
+
+	if handlerID != callerID {
+		t.Errorf("Handler ran on goroutine %d, want caller goroutine %d", handlerID, callerID)
+	}
+}
+
+// goroutineID extracts the current goroutine's ID from the runtime stack.
+// Test-only helper; never use this pattern in production code.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// Stack output starts with "goroutine N [...]"
+	var id uint64
+	fmt.Sscanf(string(buf), "goroutine %d", &id)
+	return id
+}
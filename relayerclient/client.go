@@ -0,0 +1,174 @@
+// Package relayerclient provides a Go client for the relayerhttp batch
+// endpoint. It handles JSON encoding, retries on 5xx responses with
+// exponential backoff, connection reuse via a shared http.Client, and
+// optional request signing, so service-to-service callers don't
+// hand-roll HTTP code.
+package relayerclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// Default retry policy applied when no options are given.
+const (
+	DefaultMaxRetries = 2
+	DefaultBackoff    = 100 * time.Millisecond
+)
+
+// Signer mutates an outgoing request before it is sent, typically to add
+// authentication headers. Returning an error aborts the call.
+type Signer func(*http.Request) error
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the underlying http.Client, for custom transports,
+// timeouts, or TLS configuration. Panics if client is nil.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		if client == nil {
+			panic("http client cannot be nil")
+		}
+		c.httpClient = client
+	}
+}
+
+// WithRetries sets the retry policy for 5xx responses and transport
+// errors: up to max retries with exponential backoff starting at
+// backoff. Panics if max is negative or backoff is <= 0.
+func WithRetries(max int, backoff time.Duration) Option {
+	return func(c *Client) {
+		if max < 0 {
+			panic("max retries must be non-negative")
+		}
+		if backoff <= 0 {
+			panic("backoff must be positive")
+		}
+		c.maxRetries = max
+		c.backoff = backoff
+	}
+}
+
+// WithSigner sets a signer applied to every outgoing request.
+func WithSigner(signer Signer) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// batchEnvelope mirrors the JSON envelope written by relayerhttp.
+type batchEnvelope struct {
+	Results []relayer.Response   `json:"results"`
+	Summary relayer.BatchSummary `json:"summary"`
+}
+
+// Client calls a relayerhttp batch endpoint.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	maxRetries int
+	backoff    time.Duration
+	signer     Signer
+}
+
+// NewClient returns a Client for the batch endpoint at the given URL
+// (e.g. "https://api.example.com/batch"). Panics if endpoint is empty.
+//
+// Example:
+//
+//	client := relayerclient.NewClient("http://localhost:8080/batch",
+//		relayerclient.WithRetries(3, 200*time.Millisecond),
+//	)
+//	results, err := client.ExecuteBatch(ctx, batch)
+func NewClient(endpoint string, opts ...Option) *Client {
+	if endpoint == "" {
+		panic("endpoint cannot be empty")
+	}
+
+	c := &Client{
+		endpoint:   endpoint,
+		httpClient: &http.Client{},
+		maxRetries: DefaultMaxRetries,
+		backoff:    DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ExecuteBatch posts the batch and returns the per-request responses.
+// 5xx responses and transport errors are retried per the client's retry
+// policy; 4xx responses fail immediately since retrying cannot help.
+func (c *Client) ExecuteBatch(ctx context.Context, batch []relayer.SubRequest) ([]relayer.Response, error) {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return nil, fmt.Errorf("encode batch: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := c.backoff << (attempt - 1)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		results, retryable, err := c.post(ctx, body)
+		if err == nil {
+			return results, nil
+		}
+		lastErr = err
+		if !retryable {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+// post performs one HTTP attempt. The second return value reports
+// whether the failure is worth retrying.
+func (c *Client) post(ctx context.Context, body []byte) ([]relayer.Response, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.signer != nil {
+		if err := c.signer(req); err != nil {
+			return nil, false, fmt.Errorf("sign request: %w", err)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return nil, true, fmt.Errorf("batch endpoint returned %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("batch endpoint returned %d", resp.StatusCode)
+	}
+
+	var envelope batchEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return nil, false, fmt.Errorf("decode response: %w", err)
+	}
+
+	return envelope.Results, false, nil
+}
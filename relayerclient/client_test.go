@@ -0,0 +1,128 @@
+package relayerclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+	"github.com/voseghale/batching/relayerhttp"
+)
+
+func newTestEndpoint(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	server := httptest.NewServer(relayerhttp.NewHandler(orch))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_ExecuteBatch(t *testing.T) {
+	server := newTestEndpoint(t)
+	client := NewClient(server.URL)
+
+	results, err := client.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hello"},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if results[0].Status != 200 || results[0].Data != "hello" {
+		t.Errorf("result = %+v, want 200/hello", results[0])
+	}
+}
+
+func TestClient_RetriesOn5xx(t *testing.T) {
+	var calls atomic.Int32
+
+	backend := newTestEndpoint(t)
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		backend.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer flaky.Close()
+
+	client := NewClient(flaky.URL, WithRetries(2, time.Millisecond))
+
+	results, err := client.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "x"},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed after retry: %v", err)
+	}
+	if len(results) != 1 || results[0].Status != 200 {
+		t.Errorf("results = %+v, want one 200 response", results)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("endpoint called %d times, want 2", got)
+	}
+}
+
+func TestClient_NoRetryOn4xx(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithRetries(3, time.Millisecond))
+
+	if _, err := client.ExecuteBatch(context.Background(), nil); err == nil {
+		t.Fatal("ExecuteBatch should fail on 400")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("endpoint called %d times, want 1 (no retry on 4xx)", got)
+	}
+}
+
+func TestClient_Signer(t *testing.T) {
+	var gotAuth string
+
+	backend := newTestEndpoint(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		backend.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithSigner(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token-123")
+		return nil
+	}))
+
+	if _, err := client.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	}); err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if gotAuth != "Bearer token-123" {
+		t.Errorf("Authorization = %q, want signed header", gotAuth)
+	}
+}
+
+func TestNewClient_EmptyEndpointPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NewClient(\"\") should panic")
+		}
+	}()
+	NewClient("")
+}
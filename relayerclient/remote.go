@@ -0,0 +1,48 @@
+package relayerclient
+
+import (
+	"context"
+	"fmt"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// RemoteRecipe returns a handler that forwards each request to another
+// relayer instance through the client and adapts its response,
+// preserving the remote status code, data, and error details. Register
+// it under the recipe names a specialized downstream service owns, and
+// a front orchestrator fans work out to it transparently:
+//
+//	users := relayerclient.NewClient("http://users-svc/batch")
+//	orch.RegisterRecipe("get-user", relayerclient.RemoteRecipe(users))
+//	orch.RegisterRecipe("list-teams", relayerclient.RemoteRecipe(users))
+//
+// The remote request carries the local request's ID, tenant, recipe
+// name, trace ID, and payload, so traces continue across the hop.
+// Transport failures surface as regular handler errors and follow the
+// client's retry policy. Panics if c is nil.
+func RemoteRecipe(c *Client) relayer.Handler {
+	if c == nil {
+		panic("remote recipe client cannot be nil")
+	}
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		info, _ := relayer.RequestInfoFromContext(ctx)
+		results, err := c.ExecuteBatch(ctx, []relayer.SubRequest{{
+			ID:       info.RequestID,
+			TenantID: info.TenantID,
+			Recipe:   info.RecipeName,
+			Payload:  payload,
+			TraceID:  info.TraceID,
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("remote recipe %q: %w", info.RecipeName, err)
+		}
+		if len(results) != 1 {
+			return nil, fmt.Errorf("remote recipe %q: got %d results, want 1", info.RecipeName, len(results))
+		}
+		// Returning the response as a Responder preserves the remote
+		// status code and error details on the local response.
+		resp := results[0]
+		return &resp, nil
+	}
+}
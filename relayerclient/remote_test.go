@@ -0,0 +1,84 @@
+package relayerclient
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+	"github.com/voseghale/batching/relayerhttp"
+)
+
+func newRemoteOrchestrator(t *testing.T) *Client {
+	t.Helper()
+
+	remote := relayer.New()
+	remote.RegisterRecipe("get-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		tenantID, _ := relayer.TenantID(ctx)
+		return "user-of-" + tenantID, nil
+	})
+	remote.RegisterRecipe("always-fails", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("downstream broken")
+	})
+
+	server := httptest.NewServer(relayerhttp.NewHandler(remote))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL)
+}
+
+func TestRemoteRecipe_ForwardsToRemoteInstance(t *testing.T) {
+	front := relayer.New()
+	front.RegisterRecipe("get-user", RemoteRecipe(newRemoteOrchestrator(t)))
+
+	results := front.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "get-user"},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200 (error: %v)", results[0].Status, results[0].Error)
+	}
+	if results[0].Data != "user-of-tenant-a" {
+		t.Errorf("Data = %v, want user-of-tenant-a (tenant must propagate)", results[0].Data)
+	}
+}
+
+func TestRemoteRecipe_PreservesRemoteError(t *testing.T) {
+	front := relayer.New()
+	front.RegisterRecipe("always-fails", RemoteRecipe(newRemoteOrchestrator(t)))
+
+	results := front.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "always-fails"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != relayer.ErrCodeRecipeExecution {
+		t.Errorf("Error = %v, want remote %s preserved", results[0].Error, relayer.ErrCodeRecipeExecution)
+	}
+}
+
+func TestRemoteRecipe_TransportFailureIsHandlerError(t *testing.T) {
+	client := NewClient("http://127.0.0.1:1/batch", WithRetries(0, DefaultBackoff))
+
+	front := relayer.New()
+	front.RegisterRecipe("get-user", RemoteRecipe(client))
+
+	results := front.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "get-user"},
+	})
+
+	if results[0].Status != 500 {
+		t.Errorf("Status = %d, want 500", results[0].Status)
+	}
+}
+
+func TestRemoteRecipe_NilClientPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RemoteRecipe(nil) did not panic")
+		}
+	}()
+	RemoteRecipe(nil)
+}
@@ -0,0 +1,191 @@
+// Package relayergrpc provides the gRPC service implementation for the
+// relayer orchestrator. It contains the canonical batch.proto definition
+// and a transport-agnostic Service whose methods match the signatures
+// protoc-gen-go-grpc generates for BatchService, so wiring it up is a
+// thin delegation:
+//
+//	type server struct {
+//		pb.UnimplementedBatchServiceServer
+//		svc *relayergrpc.Service
+//	}
+//
+//	func (s *server) ExecuteBatch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+//		return s.svc.ExecuteBatch(ctx, fromPB(req))
+//	}
+//
+// The package itself has no dependency on google.golang.org/grpc; the
+// message structs mirror the proto messages field-for-field and payloads
+// travel as JSON documents, matching the proto's payload_json fields.
+package relayergrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// BatchRequest mirrors the batch.BatchRequest proto message.
+type BatchRequest struct {
+	Requests []*SubRequestProto
+}
+
+// SubRequestProto mirrors the batch.SubRequestProto proto message.
+type SubRequestProto struct {
+	Id          string
+	TenantId    string
+	Recipe      string
+	PayloadJson string
+	Priority    int32
+}
+
+// BatchResponse mirrors the batch.BatchResponse proto message.
+type BatchResponse struct {
+	Responses []*SubResponseProto
+	Summary   *BatchSummaryProto
+}
+
+// SubResponseProto mirrors the batch.SubResponseProto proto message.
+type SubResponseProto struct {
+	Id         string
+	Status     int32
+	DataJson   string
+	Error      *ErrorProto
+	DurationMs int64
+	TenantId   string
+}
+
+// ErrorProto mirrors the batch.ErrorProto proto message.
+type ErrorProto struct {
+	Code    string
+	Message string
+}
+
+// BatchSummaryProto mirrors the batch.BatchSummaryProto proto message.
+type BatchSummaryProto struct {
+	Total     int32
+	Successes int32
+	Failures  int32
+}
+
+// ResponseStream is the server-side stream for StreamBatch. The
+// generated BatchService_StreamBatchServer satisfies it (Context comes
+// from the embedded grpc.ServerStream).
+type ResponseStream interface {
+	Send(*SubResponseProto) error
+	Context() context.Context
+}
+
+// Service implements the BatchService RPC methods against an
+// Orchestrator.
+type Service struct {
+	orch *relayer.Orchestrator
+}
+
+// NewService returns a Service wrapping the orchestrator.
+// Panics if orch is nil.
+func NewService(orch *relayer.Orchestrator) *Service {
+	if orch == nil {
+		panic("orchestrator cannot be nil")
+	}
+	return &Service{orch: orch}
+}
+
+// ExecuteBatch implements the BatchService.ExecuteBatch RPC: it decodes
+// the proto batch, executes it, and returns all responses with a summary.
+func (s *Service) ExecuteBatch(ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+	result := s.orch.ExecuteBatchResult(ctx, toBatch(req))
+
+	responses := make([]*SubResponseProto, len(result.Responses))
+	for i, resp := range result.Responses {
+		responses[i] = toProtoResponse(resp)
+	}
+
+	return &BatchResponse{
+		Responses: responses,
+		Summary: &BatchSummaryProto{
+			Total:     int32(result.Summary.Total),
+			Successes: int32(result.Summary.Successes),
+			Failures:  int32(result.Summary.Failures),
+		},
+	}, nil
+}
+
+// StreamBatch implements the BatchService.StreamBatch RPC: responses are
+// sent on the stream as each request completes, not in batch order.
+// Returns the first Send error, abandoning the remaining responses. The
+// batch runs under the stream's context, so a client disconnect or RPC
+// deadline cancels in-flight handlers.
+func (s *Service) StreamBatch(req *BatchRequest, stream ResponseStream) error {
+	for resp := range s.orch.ExecuteBatchStream(stream.Context(), toBatch(req)) {
+		if err := stream.Send(toProtoResponse(resp)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toBatch converts proto requests to relayer SubRequests, decoding each
+// payload_json document. A payload that is not valid JSON is passed
+// through as the raw string, letting the recipe surface the error.
+func toBatch(req *BatchRequest) []relayer.SubRequest {
+	if req == nil {
+		return nil
+	}
+
+	batch := make([]relayer.SubRequest, 0, len(req.Requests))
+	for _, protoReq := range req.Requests {
+		if protoReq == nil {
+			continue
+		}
+
+		var payload interface{}
+		if protoReq.PayloadJson != "" {
+			if err := json.Unmarshal([]byte(protoReq.PayloadJson), &payload); err != nil {
+				payload = protoReq.PayloadJson
+			}
+		}
+
+		batch = append(batch, relayer.SubRequest{
+			ID:       protoReq.Id,
+			TenantID: protoReq.TenantId,
+			Recipe:   protoReq.Recipe,
+			Payload:  payload,
+			Priority: int(protoReq.Priority),
+		})
+	}
+	return batch
+}
+
+// toProtoResponse converts a relayer Response to its proto mirror,
+// serializing data to JSON. Unserializable data is reported as a 500.
+func toProtoResponse(resp relayer.Response) *SubResponseProto {
+	proto := &SubResponseProto{
+		Id:         resp.ID,
+		Status:     int32(resp.Status),
+		DurationMs: resp.Duration.Milliseconds(),
+		TenantId:   resp.TenantID,
+	}
+
+	if resp.Data != nil {
+		raw, err := json.Marshal(resp.Data)
+		if err != nil {
+			proto.Status = 500
+			proto.Error = &ErrorProto{
+				Code:    relayer.ErrCodeRecipeExecution,
+				Message: "response data is not JSON-serializable",
+			}
+			return proto
+		}
+		proto.DataJson = string(raw)
+	}
+
+	if resp.Error != nil {
+		proto.Error = &ErrorProto{
+			Code:    resp.Error.Code,
+			Message: resp.Error.Message,
+		}
+	}
+
+	return proto
+}
@@ -0,0 +1,180 @@
+package relayergrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func newTestService() *Service {
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+	return NewService(orch)
+}
+
+func TestService_ExecuteBatch(t *testing.T) {
+	svc := newTestService()
+
+	resp, err := svc.ExecuteBatch(context.Background(), &BatchRequest{
+		Requests: []*SubRequestProto{
+			{Id: "1", TenantId: "t", Recipe: "echo", PayloadJson: `{"message":"hi"}`},
+			{Id: "2", TenantId: "t", Recipe: "fail"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if len(resp.Responses) != 2 {
+		t.Fatalf("got %d responses, want 2", len(resp.Responses))
+	}
+
+	first := resp.Responses[0]
+	if first.Status != 200 {
+		t.Errorf("Status = %d, want 200", first.Status)
+	}
+	if first.DataJson != `{"message":"hi"}` {
+		t.Errorf("DataJson = %q, want round-tripped payload", first.DataJson)
+	}
+
+	second := resp.Responses[1]
+	if second.Status != 500 || second.Error == nil || second.Error.Code != relayer.ErrCodeRecipeExecution {
+		t.Errorf("failed response = %+v, want 500 RECIPE_EXECUTION", second)
+	}
+
+	if resp.Summary.Total != 2 || resp.Summary.Successes != 1 || resp.Summary.Failures != 1 {
+		t.Errorf("Summary = %+v, want total 2, successes 1, failures 1", resp.Summary)
+	}
+}
+
+func TestService_ExecuteBatch_NonJSONPayloadPassedThrough(t *testing.T) {
+	svc := newTestService()
+
+	resp, err := svc.ExecuteBatch(context.Background(), &BatchRequest{
+		Requests: []*SubRequestProto{
+			{Id: "1", TenantId: "t", Recipe: "echo", PayloadJson: "not json"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+
+	if resp.Responses[0].DataJson != `"not json"` {
+		t.Errorf("DataJson = %q, want raw string passed through", resp.Responses[0].DataJson)
+	}
+}
+
+type captureStream struct {
+	ctx     context.Context
+	sent    []*SubResponseProto
+	failAt  int
+	sendErr error
+}
+
+func (s *captureStream) Send(resp *SubResponseProto) error {
+	if s.sendErr != nil && len(s.sent) == s.failAt {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func (s *captureStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+func TestService_StreamBatch(t *testing.T) {
+	svc := newTestService()
+
+	stream := &captureStream{}
+	err := svc.StreamBatch(&BatchRequest{
+		Requests: []*SubRequestProto{
+			{Id: "1", TenantId: "t", Recipe: "echo", PayloadJson: `"a"`},
+			{Id: "2", TenantId: "t", Recipe: "echo", PayloadJson: `"b"`},
+		},
+	}, stream)
+	if err != nil {
+		t.Fatalf("StreamBatch failed: %v", err)
+	}
+
+	if len(stream.sent) != 2 {
+		t.Fatalf("streamed %d responses, want 2", len(stream.sent))
+	}
+
+	seen := map[string]bool{}
+	for _, resp := range stream.sent {
+		seen[resp.Id] = true
+		if resp.Status != 200 {
+			t.Errorf("response %s status = %d, want 200", resp.Id, resp.Status)
+		}
+	}
+	if !seen["1"] || !seen["2"] {
+		t.Errorf("streamed IDs = %v, want 1 and 2", seen)
+	}
+}
+
+func TestService_StreamBatch_UsesStreamContext(t *testing.T) {
+	orch := relayer.New()
+	orch.RegisterRecipe("wait", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	svc := NewService(orch)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := &captureStream{ctx: ctx}
+
+	err := svc.StreamBatch(&BatchRequest{
+		Requests: []*SubRequestProto{
+			{Id: "1", TenantId: "t", Recipe: "wait"},
+		},
+	}, stream)
+	if err != nil {
+		t.Fatalf("StreamBatch failed: %v", err)
+	}
+
+	if len(stream.sent) != 1 {
+		t.Fatalf("streamed %d responses, want 1", len(stream.sent))
+	}
+	if stream.sent[0].Status < 500 {
+		t.Errorf("status = %d, want 5xx once the stream context is cancelled", stream.sent[0].Status)
+	}
+}
+
+func TestService_StreamBatch_SendError(t *testing.T) {
+	svc := newTestService()
+
+	wantErr := fmt.Errorf("stream broken")
+	stream := &captureStream{sendErr: wantErr}
+
+	err := svc.StreamBatch(&BatchRequest{
+		Requests: []*SubRequestProto{
+			{Id: "1", TenantId: "t", Recipe: "echo", PayloadJson: `"a"`},
+		},
+	}, stream)
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("StreamBatch = %v, want send error propagated", err)
+	}
+}
+
+func TestNewService_NilOrchestratorPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NewService(nil) should panic")
+		}
+	}()
+	NewService(nil)
+}
@@ -0,0 +1,170 @@
+package relayerhttp
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// AdminOption configures the admin handler.
+type AdminOption func(*adminHandler)
+
+// WithAdminToken requires a bearer token on every admin request.
+// Requests without "Authorization: Bearer <token>" get a 401. Panics if
+// token is empty — an admin API must not ship unauthenticated by
+// accident; use WithoutAdminAuth to opt out explicitly.
+func WithAdminToken(token string) AdminOption {
+	return func(h *adminHandler) {
+		if token == "" {
+			panic("admin token cannot be empty")
+		}
+		h.token = token
+	}
+}
+
+// WithoutAdminAuth disables authentication. Only for handlers bound to
+// a trusted internal interface.
+func WithoutAdminAuth() AdminOption {
+	return func(h *adminHandler) {
+		h.noAuth = true
+	}
+}
+
+type adminHandler struct {
+	orch   *relayer.Orchestrator
+	token  string
+	noAuth bool
+}
+
+// NewAdminHandler returns an http.Handler exposing authenticated admin
+// routes for the orchestrator:
+//
+//	GET    /recipes          registered recipes with metadata
+//	GET    /stats            per-recipe and per-tenant statistics
+//	GET    /inflight         currently executing requests
+//	DELETE /inflight/{id}    cancel an in-flight request
+//	POST   /drain            wait for in-flight work to finish
+//	PUT    /limits           adjust limits: {"max_batch_size": n, "max_concurrency": n}
+//
+// Paths are relative to wherever the handler is mounted. Exactly one of
+// WithAdminToken or WithoutAdminAuth must be given. Panics if orch is
+// nil or no auth choice was made.
+//
+// Example:
+//
+//	mux.Handle("/admin/", http.StripPrefix("/admin",
+//		relayerhttp.NewAdminHandler(orch, relayerhttp.WithAdminToken(secret))))
+func NewAdminHandler(orch *relayer.Orchestrator, opts ...AdminOption) http.Handler {
+	if orch == nil {
+		panic("orchestrator cannot be nil")
+	}
+
+	h := &adminHandler{orch: orch}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if h.token == "" && !h.noAuth {
+		panic("admin handler requires WithAdminToken or an explicit WithoutAdminAuth")
+	}
+	return h
+}
+
+func (h *adminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		h.writeError(w, http.StatusUnauthorized, "missing or invalid admin token")
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case path == "/recipes" && r.Method == http.MethodGet:
+		h.writeJSON(w, h.orch.ListRecipes())
+
+	case path == "/stats" && r.Method == http.MethodGet:
+		h.writeJSON(w, h.orch.Stats())
+
+	case path == "/inflight" && r.Method == http.MethodGet:
+		h.writeJSON(w, h.orch.InFlight())
+
+	case strings.HasPrefix(path, "/inflight/") && r.Method == http.MethodDelete:
+		id := strings.TrimPrefix(path, "/inflight/")
+		if !h.orch.CancelRequest(id) {
+			h.writeError(w, http.StatusNotFound, "request not in flight")
+			return
+		}
+		h.writeJSON(w, map[string]string{"cancelled": id})
+
+	case path == "/drain" && r.Method == http.MethodPost:
+		if err := h.orch.Drain(r.Context()); err != nil {
+			h.writeError(w, http.StatusGatewayTimeout, err.Error())
+			return
+		}
+		h.writeJSON(w, map[string]string{"status": "drained"})
+
+	case path == "/limits" && r.Method == http.MethodPut:
+		h.updateLimits(w, r)
+
+	default:
+		h.writeError(w, http.StatusNotFound, "unknown admin route")
+	}
+}
+
+// updateLimits applies runtime limit changes. Omitted fields are left
+// unchanged.
+func (h *adminHandler) updateLimits(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		MaxBatchSize   *int `json:"max_batch_size"`
+		MaxConcurrency *int `json:"max_concurrency"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	if body.MaxBatchSize != nil {
+		if *body.MaxBatchSize < 0 {
+			h.writeError(w, http.StatusBadRequest, "max_batch_size must be non-negative")
+			return
+		}
+		h.orch.SetMaxBatchSize(*body.MaxBatchSize)
+	}
+	if body.MaxConcurrency != nil {
+		if *body.MaxConcurrency <= 0 {
+			h.writeError(w, http.StatusBadRequest, "max_concurrency must be positive")
+			return
+		}
+		if err := h.orch.SetMaxConcurrency(*body.MaxConcurrency); err != nil {
+			h.writeError(w, http.StatusConflict, err.Error())
+			return
+		}
+	}
+	h.writeJSON(w, map[string]string{"status": "updated"})
+}
+
+// authorized checks the bearer token, comparing in constant time.
+func (h *adminHandler) authorized(r *http.Request) bool {
+	if h.noAuth {
+		return true
+	}
+	auth := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(auth, prefix) {
+		return false
+	}
+	given := strings.TrimPrefix(auth, prefix)
+	return subtle.ConstantTimeCompare([]byte(given), []byte(h.token)) == 1
+}
+
+func (h *adminHandler) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (h *adminHandler) writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,132 @@
+package relayerhttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func adminRequest(t *testing.T, h http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader *strings.Reader
+	if body == "" {
+		reader = strings.NewReader("")
+	} else {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminHandler_RequiresToken(t *testing.T) {
+	orch := relayer.New()
+	h := NewAdminHandler(orch, WithAdminToken("s3cret"))
+
+	if rec := adminRequest(t, h, "GET", "/recipes", "", ""); rec.Code != 401 {
+		t.Errorf("no token: status = %d, want 401", rec.Code)
+	}
+	if rec := adminRequest(t, h, "GET", "/recipes", "wrong", ""); rec.Code != 401 {
+		t.Errorf("bad token: status = %d, want 401", rec.Code)
+	}
+	if rec := adminRequest(t, h, "GET", "/recipes", "s3cret", ""); rec.Code != 200 {
+		t.Errorf("good token: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestAdminHandler_ListsRecipesAndInflight(t *testing.T) {
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	h := NewAdminHandler(orch, WithoutAdminAuth())
+
+	rec := adminRequest(t, h, "GET", "/recipes", "", "")
+	var recipes []relayer.RecipeInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &recipes); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(recipes) != 1 || recipes[0].Name != "echo" {
+		t.Errorf("recipes = %v, want [echo]", recipes)
+	}
+
+	if rec := adminRequest(t, h, "GET", "/inflight", "", ""); rec.Code != 200 {
+		t.Errorf("inflight: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestAdminHandler_CancelsInflightRequest(t *testing.T) {
+	orch := relayer.New(relayer.WithTimeout(5 * time.Second))
+	started := make(chan struct{})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	h := NewAdminHandler(orch, WithoutAdminAuth())
+
+	done := make(chan []relayer.Response, 1)
+	go func() {
+		done <- orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+			{ID: "stuck-1", TenantID: "t", Recipe: "slow"},
+		})
+	}()
+	<-started
+
+	if rec := adminRequest(t, h, "DELETE", "/inflight/stuck-1", "", ""); rec.Code != 200 {
+		t.Fatalf("cancel: status = %d, want 200", rec.Code)
+	}
+
+	select {
+	case results := <-done:
+		if results[0].Status < 500 {
+			t.Errorf("Status = %d, want a 5xx after cancellation", results[0].Status)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("request did not complete after cancellation")
+	}
+
+	if rec := adminRequest(t, h, "DELETE", "/inflight/ghost", "", ""); rec.Code != 404 {
+		t.Errorf("cancel missing: status = %d, want 404", rec.Code)
+	}
+}
+
+func TestAdminHandler_AdjustsLimits(t *testing.T) {
+	orch := relayer.New(relayer.WithMaxConcurrency(2), relayer.WithMaxBatchSize(10))
+	h := NewAdminHandler(orch, WithoutAdminAuth())
+
+	rec := adminRequest(t, h, "PUT", "/limits", "", `{"max_batch_size": 1, "max_concurrency": 4}`)
+	if rec.Code != 200 {
+		t.Fatalf("limits: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "x"},
+		{ID: "2", TenantID: "t", Recipe: "x"},
+	})
+	if results[0].Status != 413 {
+		t.Errorf("Status = %d, want 413 after shrinking max_batch_size to 1", results[0].Status)
+	}
+}
+
+func TestAdminHandler_DrainAndUnknownRoute(t *testing.T) {
+	orch := relayer.New()
+	h := NewAdminHandler(orch, WithoutAdminAuth())
+
+	if rec := adminRequest(t, h, "POST", "/drain", "", ""); rec.Code != 200 {
+		t.Errorf("drain: status = %d, want 200", rec.Code)
+	}
+	if rec := adminRequest(t, h, "GET", "/nope", "", ""); rec.Code != 404 {
+		t.Errorf("unknown route: status = %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,36 @@
+package relayerhttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// DebugHandler returns an http.Handler serving the orchestrator's
+// debug snapshot as JSON: in-flight count, total requests, per-recipe
+// error counts, queue depth, leaked handlers, and the recipe registry.
+// Intended for quick production triage on an internal port, not as a
+// metrics endpoint — pair with relayerprom for real monitoring.
+//
+// Example:
+//
+//	mux.Handle("/debug/relayer", relayerhttp.DebugHandler(orch))
+func DebugHandler(orch *relayer.Orchestrator) http.Handler {
+	if orch == nil {
+		panic("orchestrator cannot be nil")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(orch.DebugSnapshot())
+	})
+}
@@ -0,0 +1,45 @@
+package relayerhttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func TestDebugHandler_ServesSnapshot(t *testing.T) {
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	rec := httptest.NewRecorder()
+	DebugHandler(orch).ServeHTTP(rec, httptest.NewRequest("GET", "/debug/relayer", nil))
+
+	if rec.Code != 200 {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	var snap relayer.DebugSnapshot
+	if err := json.Unmarshal(rec.Body.Bytes(), &snap); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if snap.TotalRequests != 1 {
+		t.Errorf("TotalRequests = %d, want 1", snap.TotalRequests)
+	}
+	if len(snap.Recipes) != 1 || snap.Recipes[0].Name != "echo" {
+		t.Errorf("Recipes = %v, want the registered recipe", snap.Recipes)
+	}
+}
+
+func TestDebugHandler_RejectsNonGET(t *testing.T) {
+	rec := httptest.NewRecorder()
+	DebugHandler(relayer.New()).ServeHTTP(rec, httptest.NewRequest("POST", "/debug/relayer", nil))
+	if rec.Code != 405 {
+		t.Errorf("status = %d, want 405", rec.Code)
+	}
+}
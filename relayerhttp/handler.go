@@ -0,0 +1,143 @@
+// Package relayerhttp provides a ready-made HTTP adapter for the relayer
+// orchestrator: a single handler implementing the batch endpoint with
+// body-size limits, batch validation, and a JSON response carrying the
+// results and a summary, so services don't have to copy-paste the example
+// server.
+package relayerhttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// Default limits applied when no options are given.
+const (
+	DefaultMaxBodyBytes = 1 << 20 // 1 MB
+	DefaultMaxBatchSize = 1000
+)
+
+// Option configures the batch handler.
+type Option func(*handler)
+
+// WithMaxBodyBytes caps the request body size in bytes. Panics if max
+// is <= 0.
+func WithMaxBodyBytes(max int64) Option {
+	return func(h *handler) {
+		if max <= 0 {
+			panic("max body bytes must be positive")
+		}
+		h.maxBodyBytes = max
+	}
+}
+
+// WithMaxBatchSize caps the number of requests accepted per batch.
+// Panics if max is <= 0. Keep this consistent with the orchestrator's
+// own WithMaxBatchSize limit.
+func WithMaxBatchSize(max int) Option {
+	return func(h *handler) {
+		if max <= 0 {
+			panic("max batch size must be positive")
+		}
+		h.maxBatchSize = max
+	}
+}
+
+// WithErrorLogger sets a logger for decode and encode failures. The
+// handler never writes error details to clients; logf receives them
+// instead. A nil logf disables logging (the default).
+func WithErrorLogger(logf func(format string, args ...interface{})) Option {
+	return func(h *handler) {
+		h.logf = logf
+	}
+}
+
+// batchResponse is the JSON envelope written for a successful batch.
+type batchResponse struct {
+	Results []relayer.Response   `json:"results"`
+	Summary relayer.BatchSummary `json:"summary"`
+}
+
+// errorResponse is the JSON envelope written for request-level failures.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type handler struct {
+	orch         *relayer.Orchestrator
+	maxBodyBytes int64
+	maxBatchSize int
+	logf         func(format string, args ...interface{})
+}
+
+// NewHandler returns an http.Handler implementing the batch endpoint:
+// POST a JSON array of SubRequests, receive the results plus a summary.
+// Non-POST methods get 405, oversized or malformed bodies get 400.
+//
+// Example:
+//
+//	mux.Handle("/batch", relayerhttp.NewHandler(orch,
+//		relayerhttp.WithMaxBatchSize(500),
+//	))
+func NewHandler(orch *relayer.Orchestrator, opts ...Option) http.Handler {
+	if orch == nil {
+		panic("orchestrator cannot be nil")
+	}
+
+	h := &handler{
+		orch:         orch,
+		maxBodyBytes: DefaultMaxBodyBytes,
+		maxBatchSize: DefaultMaxBatchSize,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBodyBytes)
+
+	var batch []relayer.SubRequest
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		if h.logf != nil {
+			h.logf("relayerhttp: decode error: %v", err)
+		}
+		h.writeError(w, http.StatusBadRequest, "invalid request format")
+		return
+	}
+
+	if len(batch) == 0 {
+		h.writeError(w, http.StatusBadRequest, "empty batch")
+		return
+	}
+	if len(batch) > h.maxBatchSize {
+		h.writeError(w, http.StatusRequestEntityTooLarge, "batch too large")
+		return
+	}
+
+	result := h.orch.ExecuteBatchResult(r.Context(), batch)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(batchResponse{
+		Results: result.Responses,
+		Summary: result.Summary,
+	}); err != nil && h.logf != nil {
+		h.logf("relayerhttp: encode error: %v", err)
+	}
+}
+
+func (h *handler) writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// Encoding a flat struct cannot fail; ignore the error.
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: msg})
+}
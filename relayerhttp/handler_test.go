@@ -0,0 +1,123 @@
+package relayerhttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func newTestOrchestrator() *relayer.Orchestrator {
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	return orch
+}
+
+func TestHandler_BatchSuccess(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator())
+
+	body := `[{"id":"1","tenant_id":"t","recipe":"echo","payload":"hello"}]`
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+
+	var resp struct {
+		Results []relayer.Response   `json:"results"`
+		Summary relayer.BatchSummary `json:"summary"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if len(resp.Results) != 1 || resp.Results[0].Status != 200 {
+		t.Errorf("results = %+v, want one 200 response", resp.Results)
+	}
+	if resp.Summary.Total != 1 || resp.Summary.Successes != 1 {
+		t.Errorf("summary = %+v, want total 1, successes 1", resp.Summary)
+	}
+}
+
+func TestHandler_MethodNotAllowed(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator())
+
+	req := httptest.NewRequest(http.MethodGet, "/batch", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405", rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); allow != http.MethodPost {
+		t.Errorf("Allow header = %q, want POST", allow)
+	}
+}
+
+func TestHandler_InvalidJSON(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator())
+
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader("{not json"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestHandler_EmptyBatch(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator())
+
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader("[]"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for empty batch", rec.Code)
+	}
+}
+
+func TestHandler_BatchTooLarge(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator(), WithMaxBatchSize(1))
+
+	body := `[{"id":"1","tenant_id":"t","recipe":"echo"},{"id":"2","tenant_id":"t","recipe":"echo"}]`
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want 413", rec.Code)
+	}
+}
+
+func TestHandler_BodyTooLarge(t *testing.T) {
+	handler := NewHandler(newTestOrchestrator(), WithMaxBodyBytes(10))
+
+	body := `[{"id":"1","tenant_id":"t","recipe":"echo","payload":"this body exceeds ten bytes"}]`
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for oversized body", rec.Code)
+	}
+}
+
+func TestNewHandler_NilOrchestratorPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NewHandler(nil) should panic")
+		}
+	}()
+	NewHandler(nil)
+}
@@ -0,0 +1,221 @@
+// Package relayerprom provides a ready-made Prometheus metrics hook for
+// the relayer orchestrator. It has no dependency on the Prometheus client
+// library: metrics are maintained in-process and exposed in the text
+// exposition format via Hook.Handler, which any Prometheus server can
+// scrape directly.
+//
+// Exposed metrics:
+//
+//	relayer_requests_total{recipe,status}        counter
+//	relayer_requests_tenant_total{tenant}        counter (optional, see WithTenantLabel)
+//	relayer_request_duration_seconds{recipe}     histogram
+//	relayer_in_flight                            gauge
+//	relayer_panics_total{recipe}                 counter
+package relayerprom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// defaultBuckets are the histogram bucket upper bounds in seconds,
+// matching the Prometheus client defaults.
+var defaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Hook implements relayer.ExecutionHook and relayer.PanicHook, recording
+// request counts, latencies, in-flight executions, and panics.
+//
+// Example:
+//
+//	hook := relayerprom.NewHook()
+//	orch := relayer.New(
+//		relayer.WithExecutionHook(hook),
+//		relayer.WithPanicHook(hook),
+//	)
+//	http.Handle("/metrics", hook.Handler())
+type Hook struct {
+	tenantLabel bool
+	buckets     []float64
+
+	mu         sync.Mutex
+	requests   map[[2]string]uint64 // {recipe, status} -> count
+	tenants    map[string]uint64    // tenant -> count
+	histograms map[string]*histogram
+	panics     map[string]uint64 // recipe -> count
+	inFlight   int64
+}
+
+type histogram struct {
+	counts []uint64 // one per bucket, cumulative at render time
+	sum    float64
+	count  uint64
+}
+
+// HookOption configures a Hook.
+type HookOption func(*Hook)
+
+// WithTenantLabel enables the per-tenant request counter. Off by default
+// because tenant IDs can be high-cardinality.
+func WithTenantLabel() HookOption {
+	return func(h *Hook) { h.tenantLabel = true }
+}
+
+// WithBuckets overrides the latency histogram bucket upper bounds
+// (in seconds, ascending).
+func WithBuckets(buckets []float64) HookOption {
+	return func(h *Hook) {
+		if len(buckets) > 0 {
+			h.buckets = append([]float64(nil), buckets...)
+		}
+	}
+}
+
+// NewHook creates a metrics hook with default buckets.
+func NewHook(opts ...HookOption) *Hook {
+	h := &Hook{
+		buckets:    defaultBuckets,
+		requests:   make(map[[2]string]uint64),
+		tenants:    make(map[string]uint64),
+		histograms: make(map[string]*histogram),
+		panics:     make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// OnStart increments the in-flight gauge.
+func (h *Hook) OnStart(ctx context.Context, req relayer.SubRequest) {
+	h.mu.Lock()
+	h.inFlight++
+	h.mu.Unlock()
+}
+
+// OnComplete records the request outcome and latency.
+func (h *Hook) OnComplete(ctx context.Context, req relayer.SubRequest, resp relayer.Response, duration time.Duration) {
+	seconds := duration.Seconds()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.inFlight--
+	h.requests[[2]string{req.Recipe, strconv.Itoa(resp.Status)}]++
+	if h.tenantLabel && req.TenantID != "" {
+		h.tenants[req.TenantID]++
+	}
+
+	hist, exists := h.histograms[req.Recipe]
+	if !exists {
+		hist = &histogram{counts: make([]uint64, len(h.buckets))}
+		h.histograms[req.Recipe] = hist
+	}
+	for i, upper := range h.buckets {
+		if seconds <= upper {
+			hist.counts[i]++
+			break
+		}
+	}
+	hist.sum += seconds
+	hist.count++
+}
+
+// OnPanic counts recipe panics.
+func (h *Hook) OnPanic(ctx context.Context, req relayer.SubRequest, recovered interface{}) {
+	h.mu.Lock()
+	h.panics[req.Recipe]++
+	h.mu.Unlock()
+}
+
+// Handler returns an http.Handler serving the metrics in Prometheus text
+// exposition format.
+func (h *Hook) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		w.Write([]byte(h.render()))
+	})
+}
+
+// render serializes current metric values. Label sets are sorted so the
+// output is deterministic.
+func (h *Hook) render() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var out []byte
+
+	out = append(out, "# HELP relayer_requests_total Total requests processed, by recipe and status.\n"...)
+	out = append(out, "# TYPE relayer_requests_total counter\n"...)
+	reqKeys := make([][2]string, 0, len(h.requests))
+	for k := range h.requests {
+		reqKeys = append(reqKeys, k)
+	}
+	sort.Slice(reqKeys, func(i, j int) bool {
+		if reqKeys[i][0] != reqKeys[j][0] {
+			return reqKeys[i][0] < reqKeys[j][0]
+		}
+		return reqKeys[i][1] < reqKeys[j][1]
+	})
+	for _, k := range reqKeys {
+		out = append(out, fmt.Sprintf("relayer_requests_total{recipe=%q,status=%q} %d\n", k[0], k[1], h.requests[k])...)
+	}
+
+	if h.tenantLabel {
+		out = append(out, "# HELP relayer_requests_tenant_total Total requests processed, by tenant.\n"...)
+		out = append(out, "# TYPE relayer_requests_tenant_total counter\n"...)
+		tenantKeys := make([]string, 0, len(h.tenants))
+		for k := range h.tenants {
+			tenantKeys = append(tenantKeys, k)
+		}
+		sort.Strings(tenantKeys)
+		for _, k := range tenantKeys {
+			out = append(out, fmt.Sprintf("relayer_requests_tenant_total{tenant=%q} %d\n", k, h.tenants[k])...)
+		}
+	}
+
+	out = append(out, "# HELP relayer_request_duration_seconds Request duration, by recipe.\n"...)
+	out = append(out, "# TYPE relayer_request_duration_seconds histogram\n"...)
+	histKeys := make([]string, 0, len(h.histograms))
+	for k := range h.histograms {
+		histKeys = append(histKeys, k)
+	}
+	sort.Strings(histKeys)
+	for _, recipe := range histKeys {
+		hist := h.histograms[recipe]
+		cumulative := uint64(0)
+		for i, upper := range h.buckets {
+			cumulative += hist.counts[i]
+			out = append(out, fmt.Sprintf("relayer_request_duration_seconds_bucket{recipe=%q,le=%q} %d\n",
+				recipe, strconv.FormatFloat(upper, 'g', -1, 64), cumulative)...)
+		}
+		out = append(out, fmt.Sprintf("relayer_request_duration_seconds_bucket{recipe=%q,le=\"+Inf\"} %d\n", recipe, hist.count)...)
+		out = append(out, fmt.Sprintf("relayer_request_duration_seconds_sum{recipe=%q} %g\n", recipe, hist.sum)...)
+		out = append(out, fmt.Sprintf("relayer_request_duration_seconds_count{recipe=%q} %d\n", recipe, hist.count)...)
+	}
+
+	out = append(out, "# HELP relayer_in_flight Requests currently executing.\n"...)
+	out = append(out, "# TYPE relayer_in_flight gauge\n"...)
+	out = append(out, fmt.Sprintf("relayer_in_flight %d\n", h.inFlight)...)
+
+	if len(h.panics) > 0 {
+		out = append(out, "# HELP relayer_panics_total Recipe panics, by recipe.\n"...)
+		out = append(out, "# TYPE relayer_panics_total counter\n"...)
+		panicKeys := make([]string, 0, len(h.panics))
+		for k := range h.panics {
+			panicKeys = append(panicKeys, k)
+		}
+		sort.Strings(panicKeys)
+		for _, k := range panicKeys {
+			out = append(out, fmt.Sprintf("relayer_panics_total{recipe=%q} %d\n", k, h.panics[k])...)
+		}
+	}
+
+	return string(out)
+}
@@ -0,0 +1,94 @@
+package relayerprom
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func TestHook_CountsRequestsByRecipeAndStatus(t *testing.T) {
+	hook := NewHook()
+	orch := relayer.New(
+		relayer.WithExecutionHook(hook),
+		relayer.WithPanicHook(hook),
+	)
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+		{ID: "2", TenantID: "t", Recipe: "echo"},
+		{ID: "3", TenantID: "t", Recipe: "missing"},
+	})
+
+	body := scrape(t, hook)
+
+	if !strings.Contains(body, `relayer_requests_total{recipe="echo",status="200"} 2`) {
+		t.Errorf("Missing echo/200 counter in:\n%s", body)
+	}
+	if !strings.Contains(body, `relayer_requests_total{recipe="missing",status="404"} 1`) {
+		t.Errorf("Missing missing/404 counter in:\n%s", body)
+	}
+	if !strings.Contains(body, `relayer_request_duration_seconds_count{recipe="echo"} 2`) {
+		t.Errorf("Missing duration histogram count in:\n%s", body)
+	}
+	if !strings.Contains(body, "relayer_in_flight 0") {
+		t.Errorf("Missing in-flight gauge in:\n%s", body)
+	}
+}
+
+func TestHook_CountsPanics(t *testing.T) {
+	hook := NewHook()
+	orch := relayer.New(
+		relayer.WithExecutionHook(hook),
+		relayer.WithPanicHook(hook),
+	)
+
+	orch.RegisterRecipe("panicky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "panicky"},
+	})
+
+	body := scrape(t, hook)
+
+	if !strings.Contains(body, `relayer_panics_total{recipe="panicky"} 1`) {
+		t.Errorf("Missing panic counter in:\n%s", body)
+	}
+}
+
+func TestHook_TenantLabelOptIn(t *testing.T) {
+	hook := NewHook(WithTenantLabel())
+	orch := relayer.New(relayer.WithExecutionHook(hook))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	body := scrape(t, hook)
+
+	if !strings.Contains(body, `relayer_requests_tenant_total{tenant="tenant-a"} 1`) {
+		t.Errorf("Missing tenant counter in:\n%s", body)
+	}
+}
+
+func scrape(t *testing.T, hook *Hook) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	hook.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if rec.Code != 200 {
+		t.Fatalf("Scrape status = %d, want 200", rec.Code)
+	}
+	return rec.Body.String()
+}
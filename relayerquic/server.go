@@ -0,0 +1,133 @@
+// Package relayerquic provides a QUIC/HTTP3-oriented stream adapter for
+// the relayer orchestrator. A client writes a JSON array of SubRequests
+// to a stream; the server streams each Response back as a newline-
+// delimited JSON object the moment it completes, exploiting QUIC's
+// cheap multiplexed streams for low-latency partial results.
+//
+// The package depends only on the standard library: streams are consumed
+// through the Stream interface (io.ReadWriteCloser), which quic-go's
+// quic.Stream already satisfies. Wiring a listener is a thin loop:
+//
+//	srv := relayerquic.NewServer(orch)
+//	for {
+//		stream, err := conn.AcceptStream(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		go srv.HandleStream(ctx, stream)
+//	}
+//
+// Any bidirectional byte stream works, so the same server can sit on a
+// plain net.Conn for testing or non-QUIC deployments.
+package relayerquic
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// DefaultMaxBatchSize caps batch sizes when no option is given.
+const DefaultMaxBatchSize = 1000
+
+// Stream is a bidirectional byte stream carrying one batch exchange.
+// quic.Stream and net.Conn both satisfy it.
+type Stream = io.ReadWriteCloser
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithMaxBatchSize caps the number of requests accepted per stream.
+// Panics if max is <= 0.
+func WithMaxBatchSize(max int) Option {
+	return func(s *Server) {
+		if max <= 0 {
+			panic("max batch size must be positive")
+		}
+		s.maxBatchSize = max
+	}
+}
+
+// WithErrorLogger sets a logger for decode and write failures. A nil
+// logf disables logging (the default).
+func WithErrorLogger(logf func(format string, args ...interface{})) Option {
+	return func(s *Server) {
+		s.logf = logf
+	}
+}
+
+// streamError is the JSON object written when a batch cannot be decoded
+// or admitted. Its presence of the "error" key distinguishes it from a
+// Response line.
+type streamError struct {
+	Error string `json:"error"`
+}
+
+// Server handles batch exchanges over individual streams.
+type Server struct {
+	orch         *relayer.Orchestrator
+	maxBatchSize int
+	logf         func(format string, args ...interface{})
+}
+
+// NewServer returns a Server wrapping the orchestrator.
+// Panics if orch is nil.
+func NewServer(orch *relayer.Orchestrator, opts ...Option) *Server {
+	if orch == nil {
+		panic("orchestrator cannot be nil")
+	}
+
+	s := &Server{
+		orch:         orch,
+		maxBatchSize: DefaultMaxBatchSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// HandleStream processes one batch exchange: it decodes a JSON array of
+// SubRequests from the stream, executes it, and writes each Response as
+// a newline-delimited JSON object as soon as it completes. The stream is
+// closed when the batch is done. Intended to be called in its own
+// goroutine per accepted stream.
+func (s *Server) HandleStream(ctx context.Context, stream Stream) {
+	defer stream.Close()
+
+	var batch []relayer.SubRequest
+	if err := json.NewDecoder(stream).Decode(&batch); err != nil {
+		if s.logf != nil {
+			s.logf("relayerquic: decode error: %v", err)
+		}
+		s.writeError(stream, "invalid request format")
+		return
+	}
+
+	if len(batch) == 0 {
+		s.writeError(stream, "empty batch")
+		return
+	}
+	if len(batch) > s.maxBatchSize {
+		s.writeError(stream, "batch too large")
+		return
+	}
+
+	encoder := json.NewEncoder(stream)
+	for resp := range s.orch.ExecuteBatchStream(ctx, batch) {
+		if err := encoder.Encode(resp); err != nil {
+			if s.logf != nil {
+				s.logf("relayerquic: write error: %v", err)
+			}
+			return
+		}
+	}
+}
+
+func (s *Server) writeError(stream Stream, msg string) {
+	// Encoding a flat struct cannot fail; write errors are best-effort
+	// since the peer may already be gone.
+	_ = json.NewEncoder(stream).Encode(streamError{Error: msg})
+}
@@ -0,0 +1,133 @@
+package relayerquic
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func newTestServer(opts ...Option) *Server {
+	orch := relayer.New()
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	return NewServer(orch, opts...)
+}
+
+// exchange writes a request body to the server end of a pipe and returns
+// the NDJSON lines written back.
+func exchange(t *testing.T, srv *Server, body string) []string {
+	t.Helper()
+
+	client, server := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		srv.HandleStream(context.Background(), server)
+	}()
+
+	if _, err := client.Write([]byte(body)); err != nil {
+		t.Fatalf("write request: %v", err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(client)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil && err != io.ErrClosedPipe {
+		t.Fatalf("read responses: %v", err)
+	}
+
+	<-done
+	return lines
+}
+
+func TestHandleStream_StreamsResponses(t *testing.T) {
+	srv := newTestServer()
+
+	lines := exchange(t, srv, `[
+		{"id":"1","tenant_id":"t","recipe":"echo","payload":"a"},
+		{"id":"2","tenant_id":"t","recipe":"echo","payload":"b"}
+	]`)
+
+	if len(lines) != 2 {
+		t.Fatalf("got %d response lines, want 2", len(lines))
+	}
+
+	seen := map[string]bool{}
+	for _, line := range lines {
+		var resp relayer.Response
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("response line %q is not valid JSON: %v", line, err)
+		}
+		if resp.Status != 200 {
+			t.Errorf("response %s status = %d, want 200", resp.ID, resp.Status)
+		}
+		seen[resp.ID] = true
+	}
+	if !seen["1"] || !seen["2"] {
+		t.Errorf("response IDs = %v, want 1 and 2", seen)
+	}
+}
+
+func TestHandleStream_InvalidJSON(t *testing.T) {
+	srv := newTestServer()
+
+	lines := exchange(t, srv, "{not json")
+
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1 error line", len(lines))
+	}
+
+	var errResp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &errResp); err != nil || errResp.Error == "" {
+		t.Errorf("error line = %q, want JSON with error field", lines[0])
+	}
+}
+
+func TestHandleStream_EmptyBatch(t *testing.T) {
+	srv := newTestServer()
+
+	lines := exchange(t, srv, "[]")
+
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1 error line", len(lines))
+	}
+}
+
+func TestHandleStream_BatchTooLarge(t *testing.T) {
+	srv := newTestServer(WithMaxBatchSize(1))
+
+	lines := exchange(t, srv, `[
+		{"id":"1","tenant_id":"t","recipe":"echo"},
+		{"id":"2","tenant_id":"t","recipe":"echo"}
+	]`)
+
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1 error line", len(lines))
+	}
+
+	var errResp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &errResp); err != nil || errResp.Error != "batch too large" {
+		t.Errorf("error line = %q, want 'batch too large'", lines[0])
+	}
+}
+
+func TestNewServer_NilOrchestratorPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("NewServer(nil) should panic")
+		}
+	}()
+	NewServer(nil)
+}
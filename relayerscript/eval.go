@@ -0,0 +1,439 @@
+package relayerscript
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenKind enumerates lexer token types.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp // operators and punctuation
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func newLexer(src string) *lexer { return &lexer{src: src} }
+
+// next returns the next token, skipping whitespace.
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+
+	switch {
+	case c >= '0' && c <= '9':
+		for l.pos < len(l.src) && (l.src[l.pos] >= '0' && l.src[l.pos] <= '9' || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return token{kind: tokNumber, text: l.src[start:l.pos], pos: start}, nil
+
+	case c == '"':
+		l.pos++
+		var sb strings.Builder
+		for l.pos < len(l.src) && l.src[l.pos] != '"' {
+			if l.src[l.pos] == '\\' && l.pos+1 < len(l.src) {
+				l.pos++
+			}
+			sb.WriteByte(l.src[l.pos])
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return token{}, fmt.Errorf("relayerscript: unterminated string at position %d", start)
+		}
+		l.pos++
+		return token{kind: tokString, text: sb.String(), pos: start}, nil
+
+	case isIdentStart(c):
+		for l.pos < len(l.src) && isIdentPart(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokIdent, text: l.src[start:l.pos], pos: start}, nil
+
+	default:
+		for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||"} {
+			if strings.HasPrefix(l.src[l.pos:], op) {
+				l.pos += 2
+				return token{kind: tokOp, text: op, pos: start}, nil
+			}
+		}
+		if strings.ContainsRune("+-*/%<>!.?:()", rune(c)) {
+			l.pos++
+			return token{kind: tokOp, text: string(c), pos: start}, nil
+		}
+		return token{}, fmt.Errorf("relayerscript: unexpected character %q at position %d", c, start)
+	}
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentPart(c byte) bool { return isIdentStart(c) || c >= '0' && c <= '9' }
+
+// node is an AST node evaluated against the payload.
+type node interface {
+	eval(payload interface{}) (interface{}, error)
+}
+
+type literalNode struct{ value interface{} }
+
+func (n literalNode) eval(interface{}) (interface{}, error) { return n.value, nil }
+
+type payloadNode struct{}
+
+func (payloadNode) eval(payload interface{}) (interface{}, error) { return payload, nil }
+
+type fieldNode struct {
+	base node
+	name string
+}
+
+func (n fieldNode) eval(payload interface{}) (interface{}, error) {
+	base, err := n.base.eval(payload)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := base.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("relayerscript: cannot select field %q from %T", n.name, base)
+	}
+	value, ok := obj[n.name]
+	if !ok {
+		return nil, fmt.Errorf("relayerscript: no field %q in payload", n.name)
+	}
+	return value, nil
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n unaryNode) eval(payload interface{}) (interface{}, error) {
+	v, err := n.operand.eval(payload)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "-":
+		f, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("relayerscript: cannot negate %T", v)
+		}
+		return -f, nil
+	case "!":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("relayerscript: cannot apply ! to %T", v)
+		}
+		return !b, nil
+	}
+	return nil, fmt.Errorf("relayerscript: unknown unary operator %q", n.op)
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n binaryNode) eval(payload interface{}) (interface{}, error) {
+	left, err := n.left.eval(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boolean operators short-circuit.
+	if n.op == "&&" || n.op == "||" {
+		lb, ok := left.(bool)
+		if !ok {
+			return nil, fmt.Errorf("relayerscript: %s requires booleans, got %T", n.op, left)
+		}
+		if n.op == "&&" && !lb || n.op == "||" && lb {
+			return lb, nil
+		}
+		right, err := n.right.eval(payload)
+		if err != nil {
+			return nil, err
+		}
+		rb, ok := right.(bool)
+		if !ok {
+			return nil, fmt.Errorf("relayerscript: %s requires booleans, got %T", n.op, right)
+		}
+		return rb, nil
+	}
+
+	right, err := n.right.eval(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return left == right, nil
+	case "!=":
+		return left != right, nil
+	}
+
+	// String concatenation with +.
+	if ls, ok := left.(string); ok && n.op == "+" {
+		rs, ok := right.(string)
+		if !ok {
+			return nil, fmt.Errorf("relayerscript: cannot concatenate string and %T", right)
+		}
+		return ls + rs, nil
+	}
+
+	// String ordering comparisons.
+	if ls, lok := left.(string); lok {
+		rs, rok := right.(string)
+		if rok {
+			switch n.op {
+			case "<":
+				return ls < rs, nil
+			case "<=":
+				return ls <= rs, nil
+			case ">":
+				return ls > rs, nil
+			case ">=":
+				return ls >= rs, nil
+			}
+		}
+	}
+
+	lf, lok := left.(float64)
+	rf, rok := right.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("relayerscript: %s requires numbers, got %T and %T", n.op, left, right)
+	}
+
+	switch n.op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, fmt.Errorf("relayerscript: division by zero")
+		}
+		return lf / rf, nil
+	case "%":
+		if rf == 0 {
+			return nil, fmt.Errorf("relayerscript: division by zero")
+		}
+		return float64(int64(lf) % int64(rf)), nil
+	case "<":
+		return lf < rf, nil
+	case "<=":
+		return lf <= rf, nil
+	case ">":
+		return lf > rf, nil
+	case ">=":
+		return lf >= rf, nil
+	}
+	return nil, fmt.Errorf("relayerscript: unknown operator %q", n.op)
+}
+
+type conditionalNode struct {
+	cond, then, els node
+}
+
+func (n conditionalNode) eval(payload interface{}) (interface{}, error) {
+	cond, err := n.cond.eval(payload)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := cond.(bool)
+	if !ok {
+		return nil, fmt.Errorf("relayerscript: condition must be a boolean, got %T", cond)
+	}
+	if b {
+		return n.then.eval(payload)
+	}
+	return n.els.eval(payload)
+}
+
+// binaryPrecedence returns the binding power of a binary operator, or 0
+// if the token is not a binary operator.
+func binaryPrecedence(op string) int {
+	switch op {
+	case "||":
+		return 1
+	case "&&":
+		return 2
+	case "==", "!=", "<", "<=", ">", ">=":
+		return 3
+	case "+", "-":
+		return 4
+	case "*", "/", "%":
+		return 5
+	}
+	return 0
+}
+
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+// parseExpr implements precedence climbing, then handles the ternary
+// conditional (lowest precedence, right-associative) at level 0.
+func (p *parser) parseExpr(minPrec int) (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.tok.kind == tokOp {
+		prec := binaryPrecedence(p.tok.text)
+		if prec == 0 || prec < minPrec {
+			break
+		}
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseExpr(prec + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+
+	if minPrec == 0 && p.tok.kind == tokOp && p.tok.text == "?" {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		then, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokOp || p.tok.text != ":" {
+			return nil, fmt.Errorf("relayerscript: expected ':' at position %d", p.tok.pos)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		els, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		return conditionalNode{cond: left, then: then, els: els}, nil
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.tok.kind == tokOp && (p.tok.text == "-" || p.tok.text == "!") {
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	var base node
+
+	switch {
+	case p.tok.kind == tokNumber:
+		f, err := strconv.ParseFloat(p.tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("relayerscript: invalid number %q at position %d", p.tok.text, p.tok.pos)
+		}
+		base = literalNode{value: f}
+
+	case p.tok.kind == tokString:
+		base = literalNode{value: p.tok.text}
+
+	case p.tok.kind == tokIdent:
+		switch p.tok.text {
+		case "true":
+			base = literalNode{value: true}
+		case "false":
+			base = literalNode{value: false}
+		case "null":
+			base = literalNode{value: nil}
+		case "payload":
+			base = payloadNode{}
+		default:
+			return nil, fmt.Errorf("relayerscript: unknown identifier %q at position %d", p.tok.text, p.tok.pos)
+		}
+
+	case p.tok.kind == tokOp && p.tok.text == "(":
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokOp || p.tok.text != ")" {
+			return nil, fmt.Errorf("relayerscript: expected ')' at position %d", p.tok.pos)
+		}
+		base = inner
+
+	default:
+		return nil, fmt.Errorf("relayerscript: unexpected %q at position %d", p.tok.text, p.tok.pos)
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	// Field selection binds tightest: payload.user.name.
+	for p.tok.kind == tokOp && p.tok.text == "." {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokIdent {
+			return nil, fmt.Errorf("relayerscript: expected field name at position %d", p.tok.pos)
+		}
+		base = fieldNode{base: base, name: p.tok.text}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	return base, nil
+}
@@ -0,0 +1,103 @@
+// Package relayerscript registers recipes defined by expressions instead
+// of Go code, so simple transformations — field mapping, arithmetic,
+// filtering — can be shipped through config without a deploy. It has no
+// dependency on a CEL or expr library: expressions are compiled by a
+// small built-in evaluator covering the subset those languages are
+// typically used for here.
+//
+// An expression is evaluated against the request payload, bound to the
+// identifier `payload`. JSON semantics apply: numbers are float64,
+// objects are map[string]interface{}. Supported syntax:
+//
+//	payload.user.name              field selection
+//	1 + 2 * 3, -x, 10 % 3          arithmetic
+//	"id-" + payload.id             string concatenation
+//	a == b, a < b, a >= b          comparison
+//	a && b, a || b, !a             boolean logic
+//	cond ? then : else             conditional
+//	(grouping), true, false, null  literals
+//
+// Example config, loaded with Load:
+//
+//	{
+//	  "discounted-price": "payload.price * (1 - payload.discount)",
+//	  "display-name":     "payload.nickname != null ? payload.nickname : payload.name"
+//	}
+package relayerscript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// Program is a compiled expression, safe for concurrent evaluation.
+type Program struct {
+	expr node
+	src  string
+}
+
+// Compile parses an expression into a Program. Returns an error
+// describing the offending token and position on invalid syntax.
+func Compile(src string) (*Program, error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, fmt.Errorf("relayerscript: unexpected %q at position %d", p.tok.text, p.tok.pos)
+	}
+	return &Program{expr: expr, src: src}, nil
+}
+
+// Eval evaluates the program against a request payload.
+func (p *Program) Eval(payload interface{}) (interface{}, error) {
+	return p.expr.eval(payload)
+}
+
+// Recipe compiles an expression and wraps it as a relayer.Handler.
+// Evaluation errors (missing fields, type mismatches) surface as recipe
+// errors and produce a 500 response.
+func Recipe(src string) (relayer.Handler, error) {
+	prog, err := Compile(src)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return prog.Eval(payload)
+	}, nil
+}
+
+// Load reads a JSON object mapping recipe names to expressions and
+// registers each on the orchestrator, returning the registered names.
+// No recipes are registered if any expression fails to compile, so a
+// bad config never partially applies.
+func Load(orch *relayer.Orchestrator, r io.Reader) ([]string, error) {
+	var config map[string]string
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return nil, fmt.Errorf("relayerscript: invalid config: %w", err)
+	}
+
+	handlers := make(map[string]relayer.Handler, len(config))
+	names := make([]string, 0, len(config))
+	for name, src := range config {
+		handler, err := Recipe(src)
+		if err != nil {
+			return nil, fmt.Errorf("relayerscript: recipe %q: %w", name, err)
+		}
+		handlers[name] = handler
+		names = append(names, name)
+	}
+
+	for name, handler := range handlers {
+		orch.RegisterRecipe(name, handler)
+	}
+	return names, nil
+}
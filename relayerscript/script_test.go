@@ -0,0 +1,126 @@
+package relayerscript
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func TestCompileAndEval(t *testing.T) {
+	payload := map[string]interface{}{
+		"price":    100.0,
+		"discount": 0.25,
+		"name":     "ada",
+		"nickname": nil,
+		"user":     map[string]interface{}{"id": "u-1"},
+	}
+
+	tests := []struct {
+		expr string
+		want interface{}
+	}{
+		{"1 + 2 * 3", 7.0},
+		{"(1 + 2) * 3", 9.0},
+		{"payload.price * (1 - payload.discount)", 75.0},
+		{"-payload.discount", -0.25},
+		{"10 % 3", 1.0},
+		{`"id-" + payload.user.id`, "id-u-1"},
+		{"payload.price >= 100", true},
+		{`payload.name == "ada" && !false`, true},
+		{"false || payload.discount < 0.5", true},
+		{`payload.nickname != null ? payload.nickname : payload.name`, "ada"},
+		{"null == null", true},
+	}
+
+	for _, tt := range tests {
+		prog, err := Compile(tt.expr)
+		if err != nil {
+			t.Errorf("Compile(%q) error: %v", tt.expr, err)
+			continue
+		}
+		got, err := prog.Eval(payload)
+		if err != nil {
+			t.Errorf("Eval(%q) error: %v", tt.expr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestCompile_SyntaxErrors(t *testing.T) {
+	for _, expr := range []string{"", "1 +", "payload.", "(1 + 2", `"open`, "foo", "1 @ 2"} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("Compile(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+func TestEval_MissingFieldErrors(t *testing.T) {
+	prog, err := Compile("payload.absent")
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if _, err := prog.Eval(map[string]interface{}{}); err == nil {
+		t.Error("Eval() succeeded on a missing field, want error")
+	}
+}
+
+func TestLoad_RegistersRecipes(t *testing.T) {
+	orch := relayer.New()
+	config := `{
+		"discounted-price": "payload.price * (1 - payload.discount)",
+		"is-premium":       "payload.tier == \"premium\""
+	}`
+
+	names, err := Load(orch, strings.NewReader(config))
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(names) != 2 {
+		t.Fatalf("Load() registered %d recipes, want 2", len(names))
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "discounted-price",
+			Payload: map[string]interface{}{"price": 80.0, "discount": 0.5}},
+		{ID: "2", TenantID: "t", Recipe: "is-premium",
+			Payload: map[string]interface{}{"tier": "premium"}},
+	})
+
+	if results[0].Status != 200 || results[0].Data != 40.0 {
+		t.Errorf("discounted-price got (%d, %v), want (200, 40)", results[0].Status, results[0].Data)
+	}
+	if results[1].Status != 200 || results[1].Data != true {
+		t.Errorf("is-premium got (%d, %v), want (200, true)", results[1].Status, results[1].Data)
+	}
+}
+
+func TestLoad_BadExpressionRegistersNothing(t *testing.T) {
+	orch := relayer.New()
+	config := `{"good": "1 + 1", "bad": "1 +"}`
+
+	if _, err := Load(orch, strings.NewReader(config)); err == nil {
+		t.Fatal("Load() succeeded with a bad expression, want error")
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "good"},
+	})
+	if results[0].Status != 404 {
+		t.Errorf("Status = %d, want 404: a bad config must not partially apply", results[0].Status)
+	}
+}
+
+func TestEval_RuntimeTypeErrors(t *testing.T) {
+	prog, err := Compile(`payload.count + "x"`)
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if _, err := prog.Eval(map[string]interface{}{"count": 1.0}); err == nil {
+		t.Error("Eval() succeeded mixing number and string, want error")
+	}
+}
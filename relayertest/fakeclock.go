@@ -0,0 +1,99 @@
+// Package relayertest provides test helpers for the relayer
+// orchestrator: a controllable fake clock for deterministic timeout
+// tests, and (see harness.go) a builder with canned recipes and
+// response assertions for table-driven tests.
+package relayertest
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a manually advanced relayer.Clock. Timers created by
+// After fire when Advance moves the clock past their deadline, so
+// timeout behavior can be tested without real sleeps.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFakeClock returns a FakeClock frozen at start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the clock's current (frozen) time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that fires once the clock is advanced by at
+// least d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	at := c.now.Add(d)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: at, ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward by d, firing every timer whose
+// deadline has been reached.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+
+	var due []chan time.Time
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(now) {
+			due = append(due, w.ch)
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	c.waiters = remaining
+	c.mu.Unlock()
+
+	for _, ch := range due {
+		ch <- now
+	}
+}
+
+// Waiters returns the number of timers waiting to fire. Tests use this
+// to confirm a timeout has been armed before advancing the clock.
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntilWaiters waits until at least n timers are armed or the
+// timeout elapses. Returns true if n timers became armed. This bridges
+// the gap between starting an orchestrator call on another goroutine and
+// knowing its timeout timer exists.
+func (c *FakeClock) BlockUntilWaiters(n int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if c.Waiters() >= n {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return c.Waiters() >= n
+}
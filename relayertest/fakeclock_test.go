@@ -0,0 +1,88 @@
+package relayertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func TestFakeClock_AdvanceFiresTimers(t *testing.T) {
+	clock := NewFakeClock(time.Unix(1000, 0))
+
+	ch := clock.After(5 * time.Second)
+
+	select {
+	case <-ch:
+		t.Fatal("timer fired before Advance")
+	default:
+	}
+
+	clock.Advance(3 * time.Second)
+	select {
+	case <-ch:
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(2 * time.Second)
+	select {
+	case firedAt := <-ch:
+		if !firedAt.Equal(time.Unix(1005, 0)) {
+			t.Errorf("fired at %v, want 1005", firedAt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire after its deadline passed")
+	}
+}
+
+func TestFakeClock_NowAdvances(t *testing.T) {
+	start := time.Unix(0, 0)
+	clock := NewFakeClock(start)
+
+	clock.Advance(90 * time.Second)
+
+	if got := clock.Now(); !got.Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Now() = %v, want start+90s", got)
+	}
+}
+
+func TestWithClock_DeterministicTimeout(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	orch := relayer.New(
+		relayer.WithClock(clock),
+		relayer.WithTimeout(30*time.Second),
+	)
+
+	started := make(chan struct{})
+	orch.RegisterRecipe("hang", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	resultCh := make(chan []relayer.Response, 1)
+	go func() {
+		resultCh <- orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "hang"},
+		})
+	}()
+
+	<-started
+	if !clock.BlockUntilWaiters(1, time.Second) {
+		t.Fatal("timeout timer was never armed on the fake clock")
+	}
+
+	// 30 simulated seconds pass instantly.
+	clock.Advance(30 * time.Second)
+
+	select {
+	case results := <-resultCh:
+		if results[0].Status != 504 {
+			t.Errorf("Status = %d, want 504 after simulated timeout", results[0].Status)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("batch did not complete after advancing the fake clock")
+	}
+}
@@ -0,0 +1,152 @@
+package relayertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// syncScheduler runs every scheduled task inline on the calling
+// goroutine, making batch execution fully deterministic for tests.
+type syncScheduler struct{}
+
+func (syncScheduler) Schedule(task func()) { task() }
+
+// Builder assembles an orchestrator pre-loaded with canned recipes for
+// table-driven tests. The zero value is not usable; start with
+// NewBuilder.
+type Builder struct {
+	opts        []relayer.Option
+	recipes     map[string]relayer.Handler
+	synchronous bool
+}
+
+// NewBuilder returns a Builder with no recipes registered.
+func NewBuilder() *Builder {
+	return &Builder{recipes: make(map[string]relayer.Handler)}
+}
+
+// WithOptions appends orchestrator options to apply when building.
+func (b *Builder) WithOptions(opts ...relayer.Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Synchronous makes the built orchestrator execute every request inline
+// on the calling goroutine, in batch order, so tests are deterministic
+// without channels or sleeps.
+func (b *Builder) Synchronous() *Builder {
+	b.synchronous = true
+	return b
+}
+
+// WithRecipe registers a custom recipe on the built orchestrator.
+func (b *Builder) WithRecipe(name string, handler relayer.Handler) *Builder {
+	b.recipes[name] = handler
+	return b
+}
+
+// WithEcho registers a recipe under name that returns its payload
+// unchanged.
+func (b *Builder) WithEcho(name string) *Builder {
+	return b.WithRecipe(name, EchoRecipe())
+}
+
+// WithFail registers a recipe under name that always fails with msg.
+func (b *Builder) WithFail(name, msg string) *Builder {
+	return b.WithRecipe(name, FailRecipe(msg))
+}
+
+// WithSleep registers a recipe under name that sleeps for d before
+// echoing its payload.
+func (b *Builder) WithSleep(name string, d time.Duration) *Builder {
+	return b.WithRecipe(name, SleepRecipe(d))
+}
+
+// WithPanic registers a recipe under name that panics with msg.
+func (b *Builder) WithPanic(name, msg string) *Builder {
+	return b.WithRecipe(name, PanicRecipe(msg))
+}
+
+// Build constructs the orchestrator with all accumulated options and
+// recipes registered.
+func (b *Builder) Build() *relayer.Orchestrator {
+	opts := b.opts
+	if b.synchronous {
+		opts = append(opts, relayer.WithScheduler(syncScheduler{}))
+	}
+	orch := relayer.New(opts...)
+	for name, handler := range b.recipes {
+		orch.RegisterRecipe(name, handler)
+	}
+	return orch
+}
+
+// EchoRecipe returns a recipe that returns its payload unchanged.
+func EchoRecipe() relayer.Handler {
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	}
+}
+
+// FailRecipe returns a recipe that always fails with the given message.
+func FailRecipe(msg string) relayer.Handler {
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New(msg)
+	}
+}
+
+// SleepRecipe returns a recipe that sleeps for d (or until the context
+// is cancelled) and then echoes its payload. Useful for exercising
+// timeouts and concurrency limits.
+func SleepRecipe(d time.Duration) relayer.Handler {
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		select {
+		case <-time.After(d):
+			return payload, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// PanicRecipe returns a recipe that panics with the given message,
+// for testing panic recovery and panic hooks.
+func PanicRecipe(msg string) relayer.Handler {
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic(msg)
+	}
+}
+
+// AssertAllSucceeded fails the test if any response has a non-2xx
+// status, reporting each failure's ID, status, and error.
+func AssertAllSucceeded(t testing.TB, results []relayer.Response) {
+	t.Helper()
+	for _, r := range results {
+		if r.Status < 200 || r.Status >= 300 {
+			if r.Error != nil {
+				t.Errorf("request %q failed: status %d, error %s: %s", r.ID, r.Status, r.Error.Code, r.Error.Message)
+			} else {
+				t.Errorf("request %q failed: status %d", r.ID, r.Status)
+			}
+		}
+	}
+}
+
+// AssertStatus fails the test unless the response with the given ID is
+// present and has the expected status.
+func AssertStatus(t testing.TB, results []relayer.Response, id string, status int) {
+	t.Helper()
+	for _, r := range results {
+		if r.ID == id {
+			if r.Status != status {
+				t.Errorf("request %q: Status = %d, want %d", id, r.Status, status)
+			}
+			return
+		}
+	}
+	t.Errorf("no response with ID %q in results", id)
+}
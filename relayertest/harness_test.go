@@ -0,0 +1,83 @@
+package relayertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+func TestBuilder_CannedRecipes(t *testing.T) {
+	orch := NewBuilder().
+		Synchronous().
+		WithEcho("echo").
+		WithFail("fail", "boom").
+		WithPanic("explode", "kaboom").
+		Build()
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hello"},
+		{ID: "2", TenantID: "t", Recipe: "fail"},
+		{ID: "3", TenantID: "t", Recipe: "explode"},
+		{ID: "4", TenantID: "t", Recipe: "missing"},
+	})
+
+	AssertStatus(t, results, "1", 200)
+	AssertStatus(t, results, "2", 500)
+	AssertStatus(t, results, "3", 500)
+	AssertStatus(t, results, "4", 404)
+
+	for _, r := range results {
+		if r.ID == "1" && r.Data != "hello" {
+			t.Errorf("echo Data = %v, want hello", r.Data)
+		}
+	}
+}
+
+func TestBuilder_SynchronousIsDeterministic(t *testing.T) {
+	var order []string
+	orch := NewBuilder().
+		Synchronous().
+		WithRecipe("record", func(ctx context.Context, payload interface{}) (interface{}, error) {
+			order = append(order, payload.(string))
+			return nil, nil
+		}).
+		Build()
+
+	orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "record", Payload: "a"},
+		{ID: "2", TenantID: "t", Recipe: "record", Payload: "b"},
+		{ID: "3", TenantID: "t", Recipe: "record", Payload: "c"},
+	})
+
+	// Synchronous mode runs requests inline in batch order, so the
+	// unguarded slice append above is safe and the order is fixed.
+	if len(order) != 3 || order[0] != "a" || order[1] != "b" || order[2] != "c" {
+		t.Errorf("execution order = %v, want [a b c]", order)
+	}
+}
+
+func TestBuilder_SleepRecipeHonorsTimeout(t *testing.T) {
+	orch := NewBuilder().
+		WithOptions(relayer.WithTimeout(20*time.Millisecond)).
+		WithSleep("slow", time.Second).
+		Build()
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	AssertStatus(t, results, "1", 504)
+}
+
+func TestAssertAllSucceeded_PassesOnSuccess(t *testing.T) {
+	orch := NewBuilder().Synchronous().WithEcho("echo").Build()
+
+	results := orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+		{ID: "2", TenantID: "t", Recipe: "echo"},
+	})
+
+	AssertAllSucceeded(t, results)
+}
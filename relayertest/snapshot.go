@@ -0,0 +1,102 @@
+package relayertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// SnapshotResponses compares the batch results against a golden file,
+// failing the test on any mismatch. Before comparing, fields that vary
+// between runs are normalized: Duration and QueueDuration are zeroed,
+// generated TraceID and BatchID values are replaced with placeholders,
+// and StartedAt/CompletedAt timestamps are cleared — so snapshots stay
+// stable across machines and refactors while still locking in status
+// codes, data, and error shapes.
+//
+// If the golden file does not exist it is written from the current
+// results and the test logs that a snapshot was created. Set
+// UPDATE_SNAPSHOTS=1 in the environment to rewrite existing files after
+// an intentional behavior change.
+//
+// Example:
+//
+//	results := orch.ExecuteBatch(ctx, batch)
+//	relayertest.SnapshotResponses(t, results, "testdata/case1.json")
+func SnapshotResponses(t testing.TB, results []relayer.Response, path string) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(normalizeResponses(results), "", "  ")
+	if err != nil {
+		t.Fatalf("snapshot: marshal results: %v", err)
+	}
+	got = append(got, '\n')
+
+	want, err := os.ReadFile(path)
+	if os.IsNotExist(err) || os.Getenv("UPDATE_SNAPSHOTS") == "1" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("snapshot: create directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("snapshot: write %s: %v", path, err)
+		}
+		t.Logf("snapshot: wrote %s", path)
+		return
+	}
+	if err != nil {
+		t.Fatalf("snapshot: read %s: %v", path, err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("results do not match snapshot %s (set UPDATE_SNAPSHOTS=1 to rewrite)\n--- want\n%s--- got\n%s", path, want, got)
+	}
+}
+
+// normalizeResponses strips run-to-run variance from responses so they
+// compare stably against a golden file.
+func normalizeResponses(results []relayer.Response) []relayer.Response {
+	normalized := make([]relayer.Response, len(results))
+	for i, r := range results {
+		r.Duration = 0
+		r.QueueDuration = 0
+		r.StartedAt = nil
+		r.CompletedAt = nil
+		if r.TraceID != "" {
+			r.TraceID = "[trace-id]"
+		}
+		if r.BatchID != "" {
+			r.BatchID = "[batch-id]"
+		}
+		if looksGeneratedID(r.ID) {
+			r.ID = "[generated-id]"
+		}
+		normalized[i] = r
+	}
+	return normalized
+}
+
+// looksGeneratedID reports whether the ID has the UUID shape produced
+// by WithAutoRequestID, so auto-filled IDs don't churn snapshots while
+// caller-chosen IDs are preserved.
+func looksGeneratedID(id string) bool {
+	if len(id) != 36 {
+		return false
+	}
+	for i, c := range id {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
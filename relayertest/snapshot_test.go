@@ -0,0 +1,111 @@
+package relayertest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// recordingT captures failures so tests can assert on the helper's own
+// behavior without failing themselves.
+type recordingT struct {
+	testing.TB
+	failed   bool
+	messages []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.failed = true
+	r.messages = append(r.messages, format)
+}
+
+func (r *recordingT) Fatalf(format string, args ...interface{}) {
+	r.failed = true
+	r.messages = append(r.messages, format)
+	panic("fatal")
+}
+
+func (r *recordingT) Logf(format string, args ...interface{}) {}
+
+func TestSnapshotResponses_CreatesAndMatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "case1.json")
+	orch := NewBuilder().Synchronous().WithEcho("echo").Build()
+
+	run := func() []relayer.Response {
+		return orch.ExecuteBatch(context.Background(), []relayer.SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "echo", Payload: "hi"},
+		})
+	}
+
+	SnapshotResponses(t, run(), path)
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("snapshot file not created: %v", err)
+	}
+
+	// A second run has different durations but must still match.
+	SnapshotResponses(t, run(), path)
+}
+
+func TestSnapshotResponses_NormalizesVaryingFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "case.json")
+	now := time.Now()
+	base := relayer.Response{
+		ID: "1", Status: 200, Data: "ok",
+		Duration: 5 * time.Millisecond, TraceID: "trace-a", BatchID: "batch-a",
+		StartedAt: &now, CompletedAt: &now,
+	}
+
+	SnapshotResponses(t, []relayer.Response{base}, path)
+
+	changed := base
+	changed.Duration = 90 * time.Millisecond
+	changed.TraceID = "trace-b"
+	changed.BatchID = "batch-b"
+	later := now.Add(time.Hour)
+	changed.StartedAt = &later
+	SnapshotResponses(t, []relayer.Response{changed}, path)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "trace-a") {
+		t.Error("snapshot retained the raw trace ID, want placeholder")
+	}
+}
+
+func TestSnapshotResponses_FailsOnBehaviorChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "case.json")
+	SnapshotResponses(t, []relayer.Response{{ID: "1", Status: 200, Data: "ok"}}, path)
+
+	rec := &recordingT{TB: t}
+	SnapshotResponses(rec, []relayer.Response{{ID: "1", Status: 500}}, path)
+	if !rec.failed {
+		t.Error("changed results did not fail against the snapshot")
+	}
+}
+
+func TestSnapshotResponses_NormalizesGeneratedIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "case.json")
+	uuid := "0198b2ce-4f00-7abc-8def-0123456789ab"
+
+	SnapshotResponses(t, []relayer.Response{{ID: uuid, Status: 200}}, path)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), uuid) {
+		t.Error("snapshot retained the generated UUID, want placeholder")
+	}
+	if !strings.Contains(string(raw), "[generated-id]") {
+		t.Error("snapshot missing the generated-ID placeholder")
+	}
+}
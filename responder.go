@@ -0,0 +1,26 @@
+package relayer
+
+// Responder lets a handler take full control of the response sent for a
+// request: status code, error details, and data. Recipes that proxy
+// downstream HTTP services implement this (or return a *Response
+// directly) to preserve upstream status codes instead of having every
+// success flattened to 200.
+type Responder interface {
+	// Response returns the response to send. The orchestrator fills in
+	// the request ID; a zero Status is treated as 200.
+	Response() Response
+}
+
+// Response implements Responder so handlers can return a *Response
+// directly.
+func (r *Response) Response() Response { return *r }
+
+// asResponder extracts a Responder from a handler result, if the handler
+// opted in to controlling its own response.
+func asResponder(data interface{}) (Responder, bool) {
+	if data == nil {
+		return nil, false
+	}
+	r, ok := data.(Responder)
+	return r, ok
+}
@@ -0,0 +1,80 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHandler_ReturnsResponse(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("proxy", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return &Response{
+			Status: 502,
+			Error: &Error{
+				Code:    "UPSTREAM_ERROR",
+				Message: "downstream service unavailable",
+			},
+		}, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "req-1", TenantID: "t", Recipe: "proxy"},
+	})
+
+	if results[0].Status != 502 {
+		t.Errorf("Status = %d, want 502", results[0].Status)
+	}
+	if results[0].ID != "req-1" {
+		t.Errorf("ID = %q, want 'req-1' (orchestrator should fill in request ID)", results[0].ID)
+	}
+	if results[0].Error == nil || results[0].Error.Code != "UPSTREAM_ERROR" {
+		t.Errorf("Error = %+v, want UPSTREAM_ERROR", results[0].Error)
+	}
+}
+
+type createdResponder struct {
+	data interface{}
+}
+
+func (c createdResponder) Response() Response {
+	return Response{Status: 201, Data: c.data}
+}
+
+func TestHandler_CustomResponder(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("create", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return createdResponder{data: "resource-id"}, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "create"},
+	})
+
+	if results[0].Status != 201 {
+		t.Errorf("Status = %d, want 201", results[0].Status)
+	}
+	if results[0].Data != "resource-id" {
+		t.Errorf("Data = %v, want 'resource-id'", results[0].Data)
+	}
+}
+
+func TestHandler_ResponderZeroStatusDefaults200(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("plain", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return &Response{Data: "ok"}, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "plain"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 for zero-status Responder", results[0].Status)
+	}
+	if results[0].Data != "ok" {
+		t.Errorf("Data = %v, want 'ok'", results[0].Data)
+	}
+}
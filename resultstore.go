@@ -0,0 +1,142 @@
+package relayer
+
+import (
+	"sync"
+	"time"
+)
+
+// ResultRecord is one completed request as persisted by a ResultStore:
+// the response plus enough metadata to find it again.
+type ResultRecord struct {
+	BatchID     string    `json:"batch_id,omitempty"`
+	Recipe      string    `json:"recipe"`
+	Response    Response  `json:"response"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// ResultFilter selects records from a ResultStore. Zero-valued fields
+// match everything, so filters compose: set TenantID and RequestID to
+// answer "what happened to request X from tenant Y".
+type ResultFilter struct {
+	BatchID   string
+	TenantID  string
+	RequestID string
+	Recipe    string
+	Status    int       // 0 matches any status
+	Since     time.Time // Inclusive lower bound on CompletedAt
+	Until     time.Time // Exclusive upper bound on CompletedAt
+}
+
+// matches reports whether the record satisfies every set field.
+func (f ResultFilter) matches(rec *ResultRecord) bool {
+	if f.BatchID != "" && rec.BatchID != f.BatchID {
+		return false
+	}
+	if f.TenantID != "" && rec.Response.TenantID != f.TenantID {
+		return false
+	}
+	if f.RequestID != "" && rec.Response.ID != f.RequestID {
+		return false
+	}
+	if f.Recipe != "" && rec.Recipe != f.Recipe {
+		return false
+	}
+	if f.Status != 0 && rec.Response.Status != f.Status {
+		return false
+	}
+	if !f.Since.IsZero() && rec.CompletedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !rec.CompletedAt.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// ResultStore persists completed request results for later inspection.
+// Implementations must be safe for concurrent use; Save is called on the
+// execution path and should be fast or buffer internally. The library
+// ships a bounded in-memory store; external stores can implement the
+// same interface.
+type ResultStore interface {
+	// Save persists one completed result.
+	Save(rec *ResultRecord) error
+
+	// Query returns records matching the filter, oldest first.
+	Query(filter ResultFilter) ([]*ResultRecord, error)
+}
+
+// WithResultStore persists every completed request's response to the
+// store, queryable via QueryResults. Panics if store is nil.
+func WithResultStore(store ResultStore) Option {
+	return func(o *Orchestrator) {
+		if store == nil {
+			panic("result store cannot be nil")
+		}
+		o.resultStore = store
+	}
+}
+
+// QueryResults returns persisted results matching the filter, for audit
+// and debug endpoints. Requires WithResultStore.
+//
+// Example:
+//
+//	// What happened to request X from tenant Y yesterday?
+//	records, err := orch.QueryResults(relayer.ResultFilter{
+//		TenantID:  "tenant-y",
+//		RequestID: "x",
+//		Since:     yesterday,
+//		Until:     today,
+//	})
+func (o *Orchestrator) QueryResults(filter ResultFilter) ([]*ResultRecord, error) {
+	if o.resultStore == nil {
+		return nil, ErrNoResultStore
+	}
+	return o.resultStore.Query(filter)
+}
+
+// MemoryResultStore is a bounded in-memory ResultStore: once full, the
+// oldest records are evicted. Suitable for debug endpoints on a single
+// process.
+type MemoryResultStore struct {
+	mu      sync.RWMutex
+	records []*ResultRecord
+	max     int
+}
+
+// NewMemoryResultStore creates a store retaining at most max records.
+// Panics if max is not positive.
+func NewMemoryResultStore(max int) *MemoryResultStore {
+	if max <= 0 {
+		panic("result store capacity must be positive")
+	}
+	return &MemoryResultStore{max: max}
+}
+
+// Save appends the record, evicting the oldest when at capacity.
+func (s *MemoryResultStore) Save(rec *ResultRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.records) == s.max {
+		copy(s.records, s.records[1:])
+		s.records = s.records[:len(s.records)-1]
+	}
+	clone := *rec
+	s.records = append(s.records, &clone)
+	return nil
+}
+
+// Query returns matching records, oldest first.
+func (s *MemoryResultStore) Query(filter ResultFilter) ([]*ResultRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []*ResultRecord
+	for _, rec := range s.records {
+		if filter.matches(rec) {
+			clone := *rec
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,87 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestQueryResults_FiltersByTenantStatusAndRequest(t *testing.T) {
+	store := NewMemoryResultStore(100)
+	orch := New(WithResultStore(store))
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "a", TenantID: "t1", Recipe: "ok"},
+		{ID: "b", TenantID: "t1", Recipe: "bad"},
+		{ID: "c", TenantID: "t2", Recipe: "ok"},
+	})
+
+	byTenant, err := orch.QueryResults(ResultFilter{TenantID: "t1"})
+	if err != nil {
+		t.Fatalf("QueryResults() error = %v", err)
+	}
+	if len(byTenant) != 2 {
+		t.Errorf("tenant filter matched %d records, want 2", len(byTenant))
+	}
+
+	failed, _ := orch.QueryResults(ResultFilter{Status: 500})
+	if len(failed) != 1 || failed[0].Response.ID != "b" {
+		t.Errorf("status filter = %v, want only request b", failed)
+	}
+
+	one, _ := orch.QueryResults(ResultFilter{TenantID: "t1", RequestID: "a"})
+	if len(one) != 1 || one[0].Recipe != "ok" {
+		t.Errorf("request filter = %v, want the ok record for a", one)
+	}
+}
+
+func TestQueryResults_TimeRange(t *testing.T) {
+	store := NewMemoryResultStore(10)
+	now := time.Now()
+	store.Save(&ResultRecord{
+		Recipe:      "r",
+		Response:    Response{ID: "old", TenantID: "t", Status: 200},
+		CompletedAt: now.Add(-48 * time.Hour),
+	})
+	store.Save(&ResultRecord{
+		Recipe:      "r",
+		Response:    Response{ID: "recent", TenantID: "t", Status: 200},
+		CompletedAt: now.Add(-time.Hour),
+	})
+
+	got, err := store.Query(ResultFilter{Since: now.Add(-24 * time.Hour), Until: now})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Response.ID != "recent" {
+		t.Errorf("time-range query = %v, want only the recent record", got)
+	}
+}
+
+func TestMemoryResultStore_EvictsOldest(t *testing.T) {
+	store := NewMemoryResultStore(2)
+	for _, id := range []string{"1", "2", "3"} {
+		store.Save(&ResultRecord{Response: Response{ID: id, Status: 200}})
+	}
+
+	all, _ := store.Query(ResultFilter{})
+	if len(all) != 2 || all[0].Response.ID != "2" || all[1].Response.ID != "3" {
+		t.Errorf("records after eviction = %v, want [2 3]", all)
+	}
+}
+
+func TestQueryResults_RequiresStore(t *testing.T) {
+	orch := New()
+
+	if _, err := orch.QueryResults(ResultFilter{}); !errors.Is(err, ErrNoResultStore) {
+		t.Errorf("error = %v, want ErrNoResultStore", err)
+	}
+}
@@ -0,0 +1,44 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// ResumeJobs re-executes jobs a previous process left in the running
+// state, typically called once at startup. Sub-requests already present
+// in a job's checkpoint keep their stored results; only the incomplete
+// remainder is executed. Resumption happens in the background — the
+// returned batch IDs can be polled via GetJob. Requests within a batch
+// must have unique IDs for checkpointing to identify them.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithJobStore(store))
+//	registerRecipes(orch)
+//	resumed, err := orch.ResumeJobs(context.Background())
+func (o *Orchestrator) ResumeJobs(ctx context.Context) ([]string, error) {
+	if o.jobStore == nil {
+		return nil, ErrNoJobStore
+	}
+
+	jobs, err := o.jobStore.ListJobs("")
+	if err != nil {
+		return nil, err
+	}
+
+	var resumed []string
+	for _, job := range jobs {
+		if job.Status != JobRunning {
+			continue
+		}
+		resumed = append(resumed, job.BatchID)
+		go func(job *Job) {
+			job.Results = o.executeJobTracked(context.WithoutCancel(ctx), job)
+			job.Status = JobCompleted
+			job.CompletedAt = time.Now()
+			o.jobStore.UpdateJob(job)
+		}(job)
+	}
+	return resumed, nil
+}
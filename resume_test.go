@@ -0,0 +1,128 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResumeJobs_SkipsCheckpointedRequests(t *testing.T) {
+	store := NewMemoryJobStore()
+
+	// Simulate a job interrupted mid-flight: request 1 completed and
+	// was checkpointed, request 2 never ran.
+	store.SaveJob(&Job{
+		BatchID: "interrupted",
+		Status:  JobRunning,
+		Requests: []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "work"},
+			{ID: "2", TenantID: "t", Recipe: "work"},
+		},
+		Checkpoint: map[string]Response{
+			"1": {ID: "1", Status: 200, Data: "from-checkpoint", TenantID: "t"},
+		},
+		SubmittedAt: time.Now(),
+	})
+
+	orch := New(WithJobStore(store))
+	var mu sync.Mutex
+	var executed []string
+	orch.RegisterRecipe("work", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		id, _ := RequestID(ctx)
+		mu.Lock()
+		executed = append(executed, id)
+		mu.Unlock()
+		return "fresh", nil
+	})
+
+	resumed, err := orch.ResumeJobs(context.Background())
+	if err != nil {
+		t.Fatalf("ResumeJobs() error = %v", err)
+	}
+	if len(resumed) != 1 || resumed[0] != "interrupted" {
+		t.Fatalf("resumed = %v, want [interrupted]", resumed)
+	}
+
+	waitForJobStatus(t, orch, "interrupted", JobCompleted)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(executed) != 1 || executed[0] != "2" {
+		t.Errorf("executed = %v, want only request 2", executed)
+	}
+
+	job, _ := orch.GetJob("interrupted")
+	if len(job.Results) != 2 {
+		t.Fatalf("len(Results) = %d, want 2", len(job.Results))
+	}
+	if job.Results[0].Data != "from-checkpoint" {
+		t.Errorf("Results[0].Data = %v, want the checkpointed result", job.Results[0].Data)
+	}
+	if job.Results[1].Data != "fresh" {
+		t.Errorf("Results[1].Data = %v, want fresh", job.Results[1].Data)
+	}
+}
+
+func TestResumeJobs_IgnoresCompletedJobs(t *testing.T) {
+	store := NewMemoryJobStore()
+	store.SaveJob(&Job{
+		BatchID:     "done",
+		Status:      JobCompleted,
+		Requests:    []SubRequest{{ID: "1", TenantID: "t", Recipe: "work"}},
+		SubmittedAt: time.Now(),
+	})
+
+	orch := New(WithJobStore(store))
+	resumed, err := orch.ResumeJobs(context.Background())
+	if err != nil {
+		t.Fatalf("ResumeJobs() error = %v", err)
+	}
+	if len(resumed) != 0 {
+		t.Errorf("resumed = %v, want none", resumed)
+	}
+}
+
+func TestSubmitBatch_CheckpointsEachCompletion(t *testing.T) {
+	store := NewMemoryJobStore()
+	orch := New(WithJobStore(store))
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("gate", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if payload == "block" {
+			<-release
+		}
+		return payload, nil
+	})
+
+	batchID, err := orch.SubmitBatch(context.Background(), []SubRequest{
+		{ID: "fast", TenantID: "t", Recipe: "gate", Payload: "go"},
+		{ID: "slow", TenantID: "t", Recipe: "gate", Payload: "block"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitBatch() error = %v", err)
+	}
+
+	// The fast request's result must be checkpointed while the slow one
+	// is still running.
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := orch.GetJob(batchID)
+		if err == nil {
+			if _, ok := job.Checkpoint["fast"]; ok {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	job, _ := orch.GetJob(batchID)
+	if _, ok := job.Checkpoint["fast"]; !ok {
+		t.Fatal("fast request was never checkpointed while the batch ran")
+	}
+	if _, ok := job.Checkpoint["slow"]; ok {
+		t.Error("slow request checkpointed before completing")
+	}
+
+	close(release)
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+}
@@ -0,0 +1,171 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// defaultBackoff is the initial backoff when a retry policy doesn't set one.
+const defaultBackoff = 10 * time.Millisecond
+
+// executeWithRetries runs a single attempt (budget-aware when configured)
+// and retries transient recipe errors per the recipe's retry policy.
+// Retried failures carry the retry count and last error in Error.Details.
+// The second return is the final attempt's raw handler error (nil on
+// success or for failures with no underlying error, e.g. unknown
+// recipes), for hooks that want the unsanitized cause.
+func (o *Orchestrator) executeWithRetries(ctx context.Context, req SubRequest) (Response, error) {
+	attempt := func(ctx context.Context) (Response, error) {
+		if budget := o.budgetFor(req.Recipe); budget != nil {
+			return o.executeWithBudget(ctx, req, budget)
+		}
+		return o.safeExecute(ctx, req)
+	}
+
+	o.mu.RLock()
+	opt := o.recipeOptions[req.Recipe]
+	o.mu.RUnlock()
+
+	// Total attempts this request may make, for deadline budgeting.
+	total := 1
+	if opt != nil && opt.MaxRetries > 0 {
+		total += opt.MaxRetries
+	}
+
+	resp, rawErr := o.budgetedAttempt(ctx, total, attempt)
+
+	// Panics are handled by the recipe's panic policy, not the regular
+	// retry loop: retryable() never matches them.
+	if opt != nil && isPanic(rawErr) {
+		resp, rawErr = o.applyPanicPolicy(ctx, req, opt, resp, rawErr, attempt)
+	}
+
+	if opt == nil || opt.MaxRetries <= 0 {
+		return resp, rawErr
+	}
+
+	backoff := opt.Backoff
+	if backoff <= 0 {
+		backoff = defaultBackoff
+	}
+
+	retries := 0
+	for retries < opt.MaxRetries && retryable(opt, resp, rawErr) {
+		// Honor the request deadline while backing off.
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return resp, rawErr
+		}
+		backoff *= 2
+		retries++
+
+		resp, rawErr = o.budgetedAttempt(ctx, total-retries, attempt)
+	}
+
+	// Annotate failures that went through retries so callers can see how
+	// hard the orchestrator tried.
+	if retries > 0 && resp.Error != nil {
+		if resp.Error.Details == nil {
+			resp.Error.Details = make(map[string]interface{})
+		}
+		resp.Error.Details["retries"] = retries
+		if rawErr != nil {
+			resp.Error.Details["last_error"] = rawErr.Error()
+		}
+	}
+
+	return resp, rawErr
+}
+
+// budgetedAttempt runs one attempt, slicing the remaining context
+// deadline evenly across the attempts still available when
+// WithTimeoutBudget is enabled. Without the option (or without a
+// deadline) the attempt runs under ctx unchanged.
+func (o *Orchestrator) budgetedAttempt(ctx context.Context, attemptsLeft int, attempt func(context.Context) (Response, error)) (Response, error) {
+	if !o.timeoutBudget || attemptsLeft <= 1 {
+		return attempt(ctx)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return attempt(ctx)
+	}
+	remaining := deadline.Sub(o.clock.Now())
+	if remaining <= 0 {
+		return attempt(ctx)
+	}
+
+	sliced, cancel := o.withTimeout(ctx, remaining/time.Duration(attemptsLeft))
+	defer cancel()
+	return attempt(sliced)
+}
+
+// isPanic reports whether the raw attempt error came from a recovered
+// panic.
+func isPanic(err error) bool {
+	_, ok := err.(*panicError)
+	return ok
+}
+
+// applyPanicPolicy handles a panicked attempt per the recipe's
+// PanicPolicy: retry it a bounded number of times, or substitute the
+// fallback handler's result. The panic hook has already fired for every
+// panicked attempt.
+func (o *Orchestrator) applyPanicPolicy(ctx context.Context, req SubRequest, opt *RecipeOption, resp Response, rawErr error, attempt func(context.Context) (Response, error)) (Response, error) {
+	switch opt.PanicPolicy {
+	case PanicRetry:
+		retries := opt.PanicRetries
+		if retries <= 0 {
+			retries = 1
+		}
+		for i := 0; i < retries && isPanic(rawErr); i++ {
+			if ctx.Err() != nil {
+				break
+			}
+			resp, rawErr = attempt(ctx)
+		}
+		return resp, rawErr
+
+	case PanicFallbackPolicy:
+		if opt.PanicFallback == nil {
+			return resp, rawErr
+		}
+		data, err := runRecovered(ctx, req.Payload, opt.PanicFallback)
+		if err != nil {
+			// Fallback failed too; the original panic response stands.
+			return resp, rawErr
+		}
+		return Response{ID: req.ID, Status: 200, Data: data}, nil
+
+	default:
+		return resp, rawErr
+	}
+}
+
+// runRecovered executes a handler with panic recovery, converting a
+// panic into an error.
+func runRecovered(ctx context.Context, payload interface{}, handler Handler) (data interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &panicError{}
+		}
+	}()
+	return handler(ctx, payload)
+}
+
+// retryable reports whether a failed attempt qualifies for a retry.
+// Only plain recipe errors are retried: timeouts have already consumed
+// the request deadline, and panics indicate a bug rather than a
+// transient condition.
+func retryable(opt *RecipeOption, resp Response, rawErr error) bool {
+	if rawErr == nil || resp.Error == nil {
+		return false
+	}
+	if resp.Error.Code != ErrCodeRecipeExecution {
+		return false
+	}
+	if opt.RetryOn != nil && !opt.RetryOn(rawErr) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetry_SucceedsAfterTransientErrors(t *testing.T) {
+	orch := New()
+
+	var calls int32
+	orch.RegisterRecipe("flaky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return nil, errors.New("transient")
+		}
+		return "ok", nil
+	}, &RecipeOption{
+		MaxRetries: 3,
+		Backoff:    time.Millisecond,
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "flaky"},
+	})
+
+	if results[0].Status != 200 {
+		t.Fatalf("Status = %d, want 200 after retries", results[0].Status)
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("Handler called %d times, want 3", n)
+	}
+}
+
+func TestRetry_ExhaustedIncludesDetails(t *testing.T) {
+	orch := New()
+
+	var calls int32
+	orch.RegisterRecipe("broken", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errors.New("permanent failure")
+	}, &RecipeOption{
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "broken"},
+	})
+
+	if results[0].Status != 500 {
+		t.Fatalf("Status = %d, want 500", results[0].Status)
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("Handler called %d times, want 3 (1 + 2 retries)", n)
+	}
+
+	details := results[0].Error.Details
+	if details["retries"] != 2 {
+		t.Errorf("Details retries = %v, want 2", details["retries"])
+	}
+	if details["last_error"] != "permanent failure" {
+		t.Errorf("Details last_error = %v, want 'permanent failure'", details["last_error"])
+	}
+}
+
+func TestRetry_RetryOnFilters(t *testing.T) {
+	orch := New()
+
+	retryableErr := errors.New("retry me")
+
+	var calls int32
+	orch.RegisterRecipe("picky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errors.New("do not retry")
+	}, &RecipeOption{
+		MaxRetries: 5,
+		Backoff:    time.Millisecond,
+		RetryOn:    func(err error) bool { return errors.Is(err, retryableErr) },
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "picky"},
+	})
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1 (error filtered by RetryOn)", n)
+	}
+}
+
+func TestRetry_PanicsAreNotRetried(t *testing.T) {
+	orch := New()
+
+	var calls int32
+	orch.RegisterRecipe("panicky", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		panic("boom")
+	}, &RecipeOption{
+		MaxRetries: 3,
+		Backoff:    time.Millisecond,
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "panicky"},
+	})
+
+	if results[0].Error.Code != ErrCodePanic {
+		t.Errorf("Error code = %s, want %s", results[0].Error.Code, ErrCodePanic)
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1 (panics must not be retried)", n)
+	}
+}
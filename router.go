@@ -0,0 +1,166 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Router presents a single ExecuteBatch facade over several
+// orchestrators, routing each sub-request by recipe name. Deployments
+// use it to keep differently tuned pools apart — say a "cpu-" pool with
+// tight concurrency, an "io-" pool with generous timeouts, and a
+// default pool of RemoteRecipe proxies — without callers knowing the
+// split.
+//
+// Resolution order: exact recipe route, then longest matching prefix
+// route, then the default orchestrator. Requests that resolve nowhere
+// fail with 404 RECIPE_NOT_FOUND.
+type Router struct {
+	exact    map[string]*Orchestrator
+	prefixes []prefixRoute
+	fallback *Orchestrator
+}
+
+// prefixRoute maps a recipe-name prefix to an orchestrator.
+type prefixRoute struct {
+	prefix string
+	orch   *Orchestrator
+}
+
+// RouterOption configures a Router.
+type RouterOption func(*Router)
+
+// RouteRecipe routes one exact recipe name. Exact routes win over
+// prefix routes. Panics if name is empty or orch is nil.
+func RouteRecipe(name string, orch *Orchestrator) RouterOption {
+	if name == "" {
+		panic("route recipe name cannot be empty")
+	}
+	if orch == nil {
+		panic("route orchestrator cannot be nil")
+	}
+	return func(r *Router) {
+		r.exact[name] = orch
+	}
+}
+
+// RoutePrefix routes every recipe whose name starts with prefix. When
+// several prefixes match, the longest wins. Panics if prefix is empty
+// or orch is nil.
+func RoutePrefix(prefix string, orch *Orchestrator) RouterOption {
+	if prefix == "" {
+		panic("route prefix cannot be empty")
+	}
+	if orch == nil {
+		panic("route orchestrator cannot be nil")
+	}
+	return func(r *Router) {
+		r.prefixes = append(r.prefixes, prefixRoute{prefix: prefix, orch: orch})
+	}
+}
+
+// RouteDefault routes recipes no other route matches. Panics if orch is
+// nil.
+func RouteDefault(orch *Orchestrator) RouterOption {
+	if orch == nil {
+		panic("route orchestrator cannot be nil")
+	}
+	return func(r *Router) {
+		r.fallback = orch
+	}
+}
+
+// NewRouter builds a Router from the given routes.
+//
+// Example:
+//
+//	router := relayer.NewRouter(
+//		relayer.RoutePrefix("cpu-", cpuPool),
+//		relayer.RoutePrefix("io-", ioPool),
+//		relayer.RouteDefault(remotePool),
+//	)
+//	results := router.ExecuteBatch(ctx, batch)
+func NewRouter(opts ...RouterOption) *Router {
+	r := &Router{exact: make(map[string]*Orchestrator)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	// Longest prefix first, so resolution is a linear scan
+	sort.SliceStable(r.prefixes, func(i, j int) bool {
+		return len(r.prefixes[i].prefix) > len(r.prefixes[j].prefix)
+	})
+	return r
+}
+
+// resolve returns the orchestrator owning the recipe, or nil when no
+// route matches.
+func (r *Router) resolve(recipe string) *Orchestrator {
+	if orch, ok := r.exact[recipe]; ok {
+		return orch
+	}
+	for _, route := range r.prefixes {
+		if strings.HasPrefix(recipe, route.prefix) {
+			return route.orch
+		}
+	}
+	return r.fallback
+}
+
+// ExecuteBatch splits the batch across the owning orchestrators,
+// executes the groups concurrently, and returns responses in input
+// order. Requests whose recipe matches no route fail with 404.
+func (r *Router) ExecuteBatch(ctx context.Context, batch []SubRequest) []Response {
+	results := make([]Response, len(batch))
+
+	// Group request indices by target orchestrator, preserving order
+	groups := make(map[*Orchestrator][]int)
+	var targets []*Orchestrator
+	for i, req := range batch {
+		orch := r.resolve(req.Recipe)
+		if orch == nil {
+			results[i] = unroutedResponse(req)
+			continue
+		}
+		if _, seen := groups[orch]; !seen {
+			targets = append(targets, orch)
+		}
+		groups[orch] = append(groups[orch], i)
+	}
+
+	var wg sync.WaitGroup
+	for _, orch := range targets {
+		indices := groups[orch]
+		sub := make([]SubRequest, len(indices))
+		for j, i := range indices {
+			sub[j] = batch[i]
+		}
+
+		wg.Add(1)
+		orch := orch
+		go func() {
+			defer wg.Done()
+			for j, resp := range orch.ExecuteBatch(ctx, sub) {
+				results[indices[j]] = resp
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// unroutedResponse is the 404 for a recipe no route matches.
+func unroutedResponse(req SubRequest) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   404,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeRecipeNotFound,
+			Message: fmt.Sprintf("no route for recipe '%s'", req.Recipe),
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func newRouterPool(t *testing.T, tag string, recipes ...string) *Orchestrator {
+	t.Helper()
+	orch := New()
+	for _, recipe := range recipes {
+		orch.RegisterRecipe(recipe, func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return tag, nil
+		})
+	}
+	return orch
+}
+
+func TestRouter_RoutesByPrefixAndTable(t *testing.T) {
+	cpu := newRouterPool(t, "cpu", "cpu-hash")
+	io := newRouterPool(t, "io", "io-fetch")
+	special := newRouterPool(t, "special", "io-fetch")
+
+	router := NewRouter(
+		RoutePrefix("cpu-", cpu),
+		RoutePrefix("io-", io),
+		RouteRecipe("io-fetch", special), // exact beats prefix
+	)
+
+	results := router.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "cpu-hash"},
+		{ID: "2", TenantID: "t", Recipe: "io-fetch"},
+	})
+
+	if results[0].Data != "cpu" {
+		t.Errorf("cpu-hash routed to %v, want cpu pool", results[0].Data)
+	}
+	if results[1].Data != "special" {
+		t.Errorf("io-fetch routed to %v, want exact route to win", results[1].Data)
+	}
+}
+
+func TestRouter_LongestPrefixWins(t *testing.T) {
+	io := newRouterPool(t, "io", "io-slow-scan")
+	slow := newRouterPool(t, "slow", "io-slow-scan")
+
+	router := NewRouter(
+		RoutePrefix("io-", io),
+		RoutePrefix("io-slow-", slow),
+	)
+
+	results := router.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "io-slow-scan"},
+	})
+
+	if results[0].Data != "slow" {
+		t.Errorf("routed to %v, want the longest prefix's pool", results[0].Data)
+	}
+}
+
+func TestRouter_DefaultAndUnrouted(t *testing.T) {
+	fallback := newRouterPool(t, "default", "anything")
+
+	withDefault := NewRouter(RouteDefault(fallback))
+	results := withDefault.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "anything"},
+	})
+	if results[0].Data != "default" {
+		t.Errorf("routed to %v, want default pool", results[0].Data)
+	}
+
+	noRoutes := NewRouter()
+	results = noRoutes.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "anything"},
+	})
+	if results[0].Status != 404 {
+		t.Errorf("Status = %d, want 404 with no matching route", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeRecipeNotFound {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodeRecipeNotFound)
+	}
+}
+
+func TestRouter_ResultsStayInInputOrder(t *testing.T) {
+	cpu := newRouterPool(t, "cpu", "cpu-a")
+	io := newRouterPool(t, "io", "io-b")
+
+	router := NewRouter(RoutePrefix("cpu-", cpu), RoutePrefix("io-", io))
+
+	results := router.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "io-b"},
+		{ID: "2", TenantID: "t", Recipe: "cpu-a"},
+		{ID: "3", TenantID: "t", Recipe: "io-b"},
+	})
+
+	want := []string{"io", "cpu", "io"}
+	for i, resp := range results {
+		if resp.Data != want[i] {
+			t.Errorf("results[%d].Data = %v, want %s", i, resp.Data, want[i])
+		}
+	}
+}
+
+func TestRouter_InvalidRoutesPanic(t *testing.T) {
+	orch := New()
+	for name, build := range map[string]func(){
+		"empty recipe": func() { RouteRecipe("", orch) },
+		"empty prefix": func() { RoutePrefix("", orch) },
+		"nil exact":    func() { RouteRecipe("x", nil) },
+		"nil default":  func() { RouteDefault(nil) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: route did not panic", name)
+				}
+			}()
+			build()
+		}()
+	}
+}
@@ -0,0 +1,84 @@
+package relayer
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"time"
+)
+
+// SamplingHook forwards only a fraction of requests to a wrapped
+// ExecutionHook, so verbose tracing hooks can stay enabled in
+// high-throughput production without drowning the logging pipeline.
+// The decision is made per request (hashed from its trace ID), so a
+// sampled request always produces both its OnStart and OnComplete
+// events. Panics are always forwarded when the wrapped hook also
+// implements PanicHook: they are rare and too important to drop.
+type SamplingHook struct {
+	hook      ExecutionHook
+	threshold uint64
+}
+
+// NewSamplingHook wraps hook so only the given fraction of requests
+// (0.0 to 1.0) reach it. Panics if hook is nil or rate is outside
+// [0, 1].
+//
+// Example:
+//
+//	// Trace one request in a thousand.
+//	orch := relayer.New(relayer.WithExecutionHook(
+//		relayer.NewSamplingHook(&VerboseTracingHook{}, 0.001),
+//	))
+func NewSamplingHook(hook ExecutionHook, rate float64) *SamplingHook {
+	if hook == nil {
+		panic("sampling hook cannot wrap nil")
+	}
+	if rate < 0 || rate > 1 {
+		panic("sampling rate must be between 0 and 1")
+	}
+	return &SamplingHook{
+		hook:      hook,
+		threshold: uint64(rate * float64(math.MaxUint64)),
+	}
+}
+
+// sampled reports whether this request's events are forwarded. Hashing
+// the trace ID keeps the decision stable across OnStart and OnComplete.
+func (h *SamplingHook) sampled(req SubRequest) bool {
+	hash := fnv.New64a()
+	hash.Write([]byte(req.TraceID))
+	hash.Write([]byte(req.ID))
+	return hash.Sum64() <= h.threshold
+}
+
+// OnStart forwards the event when the request is sampled.
+func (h *SamplingHook) OnStart(ctx context.Context, req SubRequest) {
+	if h.sampled(req) {
+		h.hook.OnStart(ctx, req)
+	}
+}
+
+// OnComplete forwards the event when the request is sampled.
+func (h *SamplingHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	if h.sampled(req) {
+		h.hook.OnComplete(ctx, req, resp, duration)
+	}
+}
+
+// OnPanic always forwards when the wrapped hook handles panics.
+func (h *SamplingHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	if ph, ok := h.hook.(PanicHook); ok {
+		ph.OnPanic(ctx, req, recovered)
+	}
+}
+
+// OnPanicWithStack always forwards the captured stack when the wrapped
+// hook accepts one, falling back to OnPanic otherwise.
+func (h *SamplingHook) OnPanicWithStack(ctx context.Context, req SubRequest, recovered interface{}, stack []byte) {
+	switch ph := h.hook.(type) {
+	case PanicStackHook:
+		ph.OnPanicWithStack(ctx, req, recovered, stack)
+	case PanicHook:
+		ph.OnPanic(ctx, req, recovered)
+	}
+}
@@ -0,0 +1,109 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingHook counts starts and completions per request ID.
+type countingHook struct {
+	mu        sync.Mutex
+	starts    map[string]int
+	completes map[string]int
+}
+
+func newCountingHook() *countingHook {
+	return &countingHook{starts: make(map[string]int), completes: make(map[string]int)}
+}
+
+func (h *countingHook) OnStart(ctx context.Context, req SubRequest) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.starts[req.ID]++
+}
+
+func (h *countingHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.completes[req.ID]++
+}
+
+func TestSamplingHook_RateZeroAndOne(t *testing.T) {
+	always := newCountingHook()
+	never := newCountingHook()
+
+	all := NewSamplingHook(always, 1.0)
+	none := NewSamplingHook(never, 0.0)
+
+	req := SubRequest{ID: "1", TenantID: "t", Recipe: "r", TraceID: "trace"}
+	for _, hook := range []*SamplingHook{all, none} {
+		hook.OnStart(context.Background(), req)
+		hook.OnComplete(context.Background(), req, Response{Status: 200}, 0)
+	}
+
+	if always.starts["1"] != 1 || always.completes["1"] != 1 {
+		t.Errorf("rate 1.0 dropped events: starts=%d completes=%d", always.starts["1"], always.completes["1"])
+	}
+	if len(never.starts) != 0 || len(never.completes) != 0 {
+		t.Errorf("rate 0.0 forwarded events: %v %v", never.starts, never.completes)
+	}
+}
+
+func TestSamplingHook_StartAndCompletePaired(t *testing.T) {
+	inner := newCountingHook()
+	orch := New(WithExecutionHook(NewSamplingHook(inner, 0.5)))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	batch := make([]SubRequest, 200)
+	for i := range batch {
+		batch[i] = SubRequest{ID: fmt.Sprintf("req-%d", i), TenantID: "t", Recipe: "ok"}
+	}
+	orch.ExecuteBatch(context.Background(), batch)
+
+	inner.mu.Lock()
+	defer inner.mu.Unlock()
+
+	// A sampled request always yields both events; an unsampled one
+	// yields neither.
+	for id, n := range inner.starts {
+		if inner.completes[id] != n {
+			t.Errorf("request %s: %d starts but %d completes", id, n, inner.completes[id])
+		}
+	}
+	for id := range inner.completes {
+		if inner.starts[id] == 0 {
+			t.Errorf("request %s completed without a start event", id)
+		}
+	}
+
+	// With 200 requests at rate 0.5, seeing none or all sampled would
+	// mean the rate is ignored.
+	if len(inner.starts) == 0 || len(inner.starts) == len(batch) {
+		t.Errorf("sampled %d of %d requests, expected a fraction", len(inner.starts), len(batch))
+	}
+}
+
+func TestSamplingHook_InvalidArguments(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewSamplingHook(rate=%v) should panic", rate)
+				}
+			}()
+			NewSamplingHook(newCountingHook(), rate)
+		}()
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewSamplingHook(nil) should panic")
+		}
+	}()
+	NewSamplingHook(nil, 0.5)
+}
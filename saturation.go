@@ -0,0 +1,64 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// WithAdmissionTimeout sets how long TryExecuteBatch waits for a free
+// execution slot before giving up with ErrSaturated. Zero (the default)
+// means it fails immediately when no slot is free. Panics if d is
+// negative.
+func WithAdmissionTimeout(d time.Duration) Option {
+	return func(o *Orchestrator) {
+		if d < 0 {
+			panic("admission timeout cannot be negative")
+		}
+		o.admissionTimeout = d
+	}
+}
+
+// TryExecuteBatch behaves like ExecuteBatch but refuses to queue behind
+// a saturated orchestrator: if no execution slot frees up within the
+// admission timeout (see WithAdmissionTimeout), it returns ErrSaturated
+// without executing anything, so callers can apply their own backoff.
+// Without WithMaxConcurrency there is nothing to saturate and this is
+// equivalent to ExecuteBatch.
+//
+// Example:
+//
+//	results, err := orch.TryExecuteBatch(ctx, batch)
+//	if errors.Is(err, relayer.ErrSaturated) {
+//		backoff()
+//	}
+func (o *Orchestrator) TryExecuteBatch(ctx context.Context, batch []SubRequest) ([]Response, error) {
+	if o.semaphore != nil {
+		if !o.semaphore.tryAcquire() {
+			if o.admissionTimeout <= 0 {
+				return nil, ErrSaturated
+			}
+			admitCtx, cancel := context.WithTimeout(ctx, o.admissionTimeout)
+			_, err := o.semaphore.acquire(admitCtx)
+			cancel()
+			if err != nil {
+				return nil, ErrSaturated
+			}
+		}
+		// The probe slot is returned immediately; requests acquire their
+		// own slots inside processRequest.
+		o.semaphore.release()
+	}
+	return o.ExecuteBatch(ctx, batch), nil
+}
+
+// tryAcquire obtains a slot only if one is free right now, without
+// queueing behind existing waiters.
+func (s *fairSemaphore) tryAcquire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inUse < s.capacity && len(s.waiters) == 0 {
+		s.inUse++
+		return true
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTryExecuteBatch_SaturatedFailsImmediately(t *testing.T) {
+	orch := New(WithMaxConcurrency(1))
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	orch.RegisterRecipe("block", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	defer close(release)
+
+	go orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "block"},
+	})
+	<-started
+
+	_, err := orch.TryExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "t", Recipe: "block"},
+	})
+	if !errors.Is(err, ErrSaturated) {
+		t.Errorf("error = %v, want ErrSaturated", err)
+	}
+}
+
+func TestTryExecuteBatch_AdmissionTimeoutAllowsShortWaits(t *testing.T) {
+	orch := New(
+		WithMaxConcurrency(1),
+		WithAdmissionTimeout(time.Second),
+	)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	orch.RegisterRecipe("block", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		select {
+		case <-started:
+		default:
+			close(started)
+			<-release
+		}
+		return "ok", nil
+	})
+
+	go orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "block"},
+	})
+	<-started
+
+	// Free the slot shortly after TryExecuteBatch starts waiting.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+
+	results, err := orch.TryExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "t", Recipe: "block"},
+	})
+	if err != nil {
+		t.Fatalf("TryExecuteBatch() error = %v", err)
+	}
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
+
+func TestTryExecuteBatch_UnlimitedConcurrency(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results, err := orch.TryExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+	if err != nil {
+		t.Fatalf("TryExecuteBatch() error = %v", err)
+	}
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
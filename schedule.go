@@ -0,0 +1,95 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ScheduleBatch persists the batch and executes it once the wall clock
+// reaches at, returning a batch ID immediately. The job is stored with
+// status JobScheduled until it starts; progress and results are then
+// available via GetJob exactly as for SubmitBatch. A scheduled batch can
+// be cancelled with CancelScheduled before it starts. Requires a
+// JobStore (see WithJobStore).
+//
+// Example:
+//
+//	// Send the reminder in one hour.
+//	batchID, err := orch.ScheduleBatch(ctx, batch, time.Now().Add(time.Hour))
+func (o *Orchestrator) ScheduleBatch(ctx context.Context, batch []SubRequest, at time.Time) (string, error) {
+	if o.jobStore == nil {
+		return "", ErrNoJobStore
+	}
+	if len(batch) == 0 {
+		return "", errors.New("cannot schedule an empty batch")
+	}
+
+	batchID := newBatchID()
+	job := &Job{
+		BatchID:     batchID,
+		Status:      JobScheduled,
+		Requests:    append([]SubRequest(nil), batch...),
+		SubmittedAt: time.Now(),
+		RunAt:       at,
+	}
+
+	if err := o.jobStore.SaveJob(job); err != nil {
+		return "", err
+	}
+
+	go o.runScheduled(context.WithoutCancel(ctx), job)
+
+	return batchID, nil
+}
+
+// runScheduled waits until the job's RunAt and executes it, persisting
+// each lifecycle transition. Shutdown abandons the wait, leaving the job
+// scheduled in the store so a restart (or an external runner) can pick
+// it up.
+func (o *Orchestrator) runScheduled(ctx context.Context, job *Job) {
+	if delay := job.RunAt.Sub(o.clock.Now()); delay > 0 {
+		select {
+		case <-o.clock.After(delay):
+		case <-o.shutdownCtx.Done():
+			return
+		}
+	}
+
+	// Re-read the stored state: the job may have been cancelled while
+	// waiting.
+	stored, err := o.jobStore.GetJob(job.BatchID)
+	if err != nil || stored.Status != JobScheduled {
+		return
+	}
+
+	job.Status = JobRunning
+	o.jobStore.UpdateJob(job)
+
+	job.Results = o.executeJobTracked(ctx, job)
+
+	job.Status = JobCompleted
+	job.CompletedAt = time.Now()
+	o.jobStore.UpdateJob(job)
+}
+
+// CancelScheduled cancels a batch scheduled via ScheduleBatch before it
+// has started. Returns an error if the job is unknown or already past
+// the scheduled state.
+func (o *Orchestrator) CancelScheduled(batchID string) error {
+	if o.jobStore == nil {
+		return ErrNoJobStore
+	}
+
+	job, err := o.jobStore.GetJob(batchID)
+	if err != nil {
+		return err
+	}
+	if job.Status != JobScheduled {
+		return fmt.Errorf("job %s is %s, not scheduled", batchID, job.Status)
+	}
+
+	job.Status = JobCancelled
+	return o.jobStore.UpdateJob(job)
+}
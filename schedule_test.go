@@ -0,0 +1,109 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestScheduleBatch_RunsAtScheduledTime(t *testing.T) {
+	orch := New(WithJobStore(NewMemoryJobStore()))
+
+	executed := make(chan time.Time, 1)
+	orch.RegisterRecipe("reminder", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed <- time.Now()
+		return nil, nil
+	})
+
+	at := time.Now().Add(40 * time.Millisecond)
+	batchID, err := orch.ScheduleBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "reminder"},
+	}, at)
+	if err != nil {
+		t.Fatalf("ScheduleBatch() error = %v", err)
+	}
+
+	job, err := orch.GetJob(batchID)
+	if err != nil {
+		t.Fatalf("GetJob() error = %v", err)
+	}
+	if job.Status != JobScheduled {
+		t.Errorf("Status = %v, want scheduled before RunAt", job.Status)
+	}
+
+	select {
+	case ran := <-executed:
+		if ran.Before(at) {
+			t.Errorf("recipe ran at %v, before scheduled time %v", ran, at)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("scheduled batch never ran")
+	}
+
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+}
+
+func TestScheduleBatch_PastTimeRunsImmediately(t *testing.T) {
+	orch := New(WithJobStore(NewMemoryJobStore()))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	batchID, err := orch.ScheduleBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	}, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("ScheduleBatch() error = %v", err)
+	}
+
+	waitForJobStatus(t, orch, batchID, JobCompleted)
+}
+
+func TestCancelScheduled(t *testing.T) {
+	orch := New(WithJobStore(NewMemoryJobStore()))
+
+	ran := make(chan struct{})
+	orch.RegisterRecipe("never", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		close(ran)
+		return nil, nil
+	})
+
+	batchID, err := orch.ScheduleBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "never"},
+	}, time.Now().Add(30*time.Millisecond))
+	if err != nil {
+		t.Fatalf("ScheduleBatch() error = %v", err)
+	}
+
+	if err := orch.CancelScheduled(batchID); err != nil {
+		t.Fatalf("CancelScheduled() error = %v", err)
+	}
+
+	select {
+	case <-ran:
+		t.Error("cancelled batch still executed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	job, _ := orch.GetJob(batchID)
+	if job.Status != JobCancelled {
+		t.Errorf("Status = %v, want cancelled", job.Status)
+	}
+
+	// Cancelling twice reports the state mismatch.
+	if err := orch.CancelScheduled(batchID); err == nil {
+		t.Error("second CancelScheduled() should fail")
+	}
+}
+
+func TestScheduleBatch_RequiresJobStore(t *testing.T) {
+	orch := New()
+
+	_, err := orch.ScheduleBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "x"},
+	}, time.Now())
+
+	if err != ErrNoJobStore {
+		t.Errorf("error = %v, want ErrNoJobStore", err)
+	}
+}
@@ -0,0 +1,151 @@
+package relayer
+
+import (
+	"sync"
+	"time"
+)
+
+// Scheduler controls how per-request work units are dispatched during
+// batch execution. The default scheduler runs each task on its own
+// goroutine; tests can substitute a deterministic implementation to
+// reproduce specific interleavings of execution, timeout, and
+// cancellation.
+type Scheduler interface {
+	// Schedule dispatches a work unit. Implementations must eventually
+	// run every scheduled task exactly once, or ExecuteBatch will block
+	// forever waiting for results.
+	Schedule(task func())
+}
+
+// goroutineScheduler is the default production scheduler: one goroutine
+// per task.
+type goroutineScheduler struct{}
+
+func (goroutineScheduler) Schedule(task func()) { go task() }
+
+// WithScheduler overrides how request work units are dispatched.
+// This is a testing extension point; production deployments should not
+// need it.
+//
+// Example:
+//
+//	sim := relayer.NewSimScheduler()
+//	orch := relayer.New(relayer.WithScheduler(sim))
+func WithScheduler(s Scheduler) Option {
+	return func(o *Orchestrator) {
+		if s == nil {
+			s = goroutineScheduler{}
+		}
+		o.scheduler = s
+	}
+}
+
+// SimScheduler is a deterministic scheduler for concurrency testing.
+// Scheduled tasks are queued instead of run, and the test drives
+// execution explicitly via Step, StepIndex, or RunAll. Because
+// ExecuteBatch blocks until all tasks finish, it must run on a separate
+// goroutine from the one driving the scheduler:
+//
+//	sim := relayer.NewSimScheduler()
+//	orch := relayer.New(relayer.WithScheduler(sim))
+//	go func() { results <- orch.ExecuteBatch(ctx, batch) }()
+//	sim.WaitPending(len(batch), time.Second)
+//	sim.StepIndex(1) // run the second request first
+//	cancel()         // now cancel the batch context
+//	sim.RunAll()     // drain the rest
+//
+// This makes races like cancellation-while-queued reproducible in unit
+// tests instead of depending on the runtime scheduler.
+type SimScheduler struct {
+	mu    sync.Mutex
+	cond  *sync.Cond
+	queue []func()
+}
+
+// NewSimScheduler creates a deterministic scheduler for tests.
+func NewSimScheduler() *SimScheduler {
+	s := &SimScheduler{}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// Schedule queues a task without running it.
+func (s *SimScheduler) Schedule(task func()) {
+	s.mu.Lock()
+	s.queue = append(s.queue, task)
+	s.mu.Unlock()
+	s.cond.Broadcast()
+}
+
+// Pending returns the number of queued, not-yet-run tasks.
+func (s *SimScheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queue)
+}
+
+// WaitPending blocks until at least n tasks are queued or the timeout
+// elapses. Returns true if n tasks became available.
+func (s *SimScheduler) WaitPending(n int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+
+	// Wake the waiter periodically so the timeout is honored even if no
+	// new tasks arrive.
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.cond.Broadcast()
+			}
+		}
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.queue) < n {
+		if time.Now().After(deadline) {
+			return false
+		}
+		s.cond.Wait()
+	}
+	return true
+}
+
+// Step runs the oldest queued task to completion on the calling
+// goroutine. Returns false if the queue is empty.
+func (s *SimScheduler) Step() bool {
+	return s.StepIndex(0)
+}
+
+// StepIndex runs the queued task at the given position to completion on
+// the calling goroutine, allowing tests to force specific interleavings.
+// Returns false if no task exists at that index.
+func (s *SimScheduler) StepIndex(i int) bool {
+	s.mu.Lock()
+	if i < 0 || i >= len(s.queue) {
+		s.mu.Unlock()
+		return false
+	}
+	task := s.queue[i]
+	s.queue = append(s.queue[:i], s.queue[i+1:]...)
+	s.mu.Unlock()
+
+	task()
+	return true
+}
+
+// RunAll drains the queue in FIFO order, including tasks scheduled while
+// draining. Returns the number of tasks run.
+func (s *SimScheduler) RunAll() int {
+	ran := 0
+	for s.Step() {
+		ran++
+	}
+	return ran
+}
@@ -0,0 +1,126 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// fairSemaphore is a FIFO-fair counting semaphore. Unlike a buffered
+// channel, which wakes blocked senders in runtime-chosen order, waiters
+// acquire strictly in arrival order. Acquisition is context-aware and
+// the current queue depth is observable for hooks and load shedding.
+type fairSemaphore struct {
+	mu       sync.Mutex
+	capacity int
+	inUse    int
+	waiters  []chan struct{}
+}
+
+func newFairSemaphore(capacity int) *fairSemaphore {
+	return &fairSemaphore{capacity: capacity}
+}
+
+// acquire obtains a slot, blocking in FIFO order behind earlier waiters.
+// Returns the time spent waiting, or the context's error if cancelled
+// first.
+func (s *fairSemaphore) acquire(ctx context.Context) (time.Duration, error) {
+	s.mu.Lock()
+	if s.inUse < s.capacity && len(s.waiters) == 0 {
+		s.inUse++
+		s.mu.Unlock()
+		return 0, nil
+	}
+
+	ready := make(chan struct{})
+	s.waiters = append(s.waiters, ready)
+	s.mu.Unlock()
+
+	start := time.Now()
+	select {
+	case <-ready:
+		return time.Since(start), nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		for i, w := range s.waiters {
+			if w == ready {
+				s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+				s.mu.Unlock()
+				return time.Since(start), ctx.Err()
+			}
+		}
+		s.mu.Unlock()
+		// The slot was handed to us concurrently with cancellation;
+		// give it back so it isn't leaked.
+		s.release()
+		return time.Since(start), ctx.Err()
+	}
+}
+
+// release returns a slot, handing it directly to the oldest waiter if
+// one exists so arrival order is preserved. After a shrink the slot is
+// retired instead of handed off until inUse fits the new capacity, so
+// resizing under sustained load actually lowers concurrency.
+func (s *fairSemaphore) release() {
+	s.mu.Lock()
+	if len(s.waiters) > 0 && s.inUse <= s.capacity {
+		ready := s.waiters[0]
+		s.waiters = s.waiters[1:]
+		s.mu.Unlock()
+		close(ready)
+		return
+	}
+	s.inUse--
+	s.mu.Unlock()
+}
+
+// resize adjusts the capacity at runtime. Growing admits queued waiters
+// immediately; shrinking lets in-flight holders finish and simply
+// admits fewer new acquirers.
+func (s *fairSemaphore) resize(capacity int) {
+	s.mu.Lock()
+	s.capacity = capacity
+	var admitted []chan struct{}
+	for s.inUse < s.capacity && len(s.waiters) > 0 {
+		admitted = append(admitted, s.waiters[0])
+		s.waiters = s.waiters[1:]
+		s.inUse++
+	}
+	s.mu.Unlock()
+
+	for _, ready := range admitted {
+		close(ready)
+	}
+}
+
+// depth returns the number of requests queued behind the semaphore.
+func (s *fairSemaphore) depth() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.waiters)
+}
+
+// QueueHook observes semaphore queueing when concurrency limiting is
+// enabled: how long each request waited for an execution slot and the
+// queue depth left behind it. Useful for alerting on saturation before
+// requests start timing out.
+type QueueHook interface {
+	// OnQueueWait is called after a request acquires its execution slot.
+	// depth is the number of requests still waiting.
+	OnQueueWait(ctx context.Context, req SubRequest, wait time.Duration, depth int)
+}
+
+// WithQueueHook sets the hook observing semaphore wait times and queue
+// depth. Has no effect unless WithMaxConcurrency is also configured.
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithMaxConcurrency(100),
+//		relayer.WithQueueHook(&MySaturationAlerter{}),
+//	)
+func WithQueueHook(hook QueueHook) Option {
+	return func(o *Orchestrator) {
+		o.queueHook = hook
+	}
+}
@@ -0,0 +1,177 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFairSemaphore_FIFOOrder(t *testing.T) {
+	sem := newFairSemaphore(1)
+
+	if _, err := sem.acquire(context.Background()); err != nil {
+		t.Fatalf("initial acquire failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+
+	// Queue three waiters, spaced out so arrival order is deterministic.
+	for i := 1; i <= 3; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			if _, err := sem.acquire(context.Background()); err != nil {
+				t.Errorf("waiter %d failed: %v", n, err)
+				return
+			}
+			mu.Lock()
+			order = append(order, n)
+			mu.Unlock()
+			sem.release()
+		}(i)
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if got := sem.depth(); got != 3 {
+		t.Errorf("depth = %d, want 3", got)
+	}
+
+	sem.release()
+	wg.Wait()
+
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 3 {
+		t.Errorf("acquisition order = %v, want [1 2 3]", order)
+	}
+}
+
+func TestFairSemaphore_CancelledWaiter(t *testing.T) {
+	sem := newFairSemaphore(1)
+	if _, err := sem.acquire(context.Background()); err != nil {
+		t.Fatalf("initial acquire failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	wait, err := sem.acquire(ctx)
+	if err == nil {
+		t.Fatal("acquire should fail once the context expires")
+	}
+	if wait <= 0 {
+		t.Error("wait duration should be positive for a cancelled waiter")
+	}
+	if got := sem.depth(); got != 0 {
+		t.Errorf("depth after cancellation = %d, want 0 (waiter removed)", got)
+	}
+
+	// The slot must still be reusable.
+	sem.release()
+	if _, err := sem.acquire(context.Background()); err != nil {
+		t.Errorf("acquire after release failed: %v", err)
+	}
+}
+
+type captureQueueHook struct {
+	mu    sync.Mutex
+	calls int
+	waits []time.Duration
+}
+
+func (h *captureQueueHook) OnQueueWait(ctx context.Context, req SubRequest, wait time.Duration, depth int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	h.waits = append(h.waits, wait)
+}
+
+func TestWithQueueHook_ObservesWaits(t *testing.T) {
+	hook := &captureQueueHook{}
+	orch := New(WithMaxConcurrency(1), WithQueueHook(hook))
+
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, nil
+	})
+
+	batch := make([]SubRequest, 3)
+	for i := range batch {
+		batch[i] = SubRequest{ID: "r", TenantID: "t", Recipe: "slow"}
+	}
+	orch.ExecuteBatch(context.Background(), batch)
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if hook.calls != 3 {
+		t.Fatalf("hook called %d times, want 3", hook.calls)
+	}
+
+	// With one slot and three 10ms requests, at least one had to wait.
+	var waited bool
+	for _, w := range hook.waits {
+		if w >= 5*time.Millisecond {
+			waited = true
+		}
+	}
+	if !waited {
+		t.Errorf("waits = %v, expected at least one queued request", hook.waits)
+	}
+}
+
+func TestFairSemaphore_ShrinkTakesEffectUnderLoad(t *testing.T) {
+	sem := newFairSemaphore(4)
+	for i := 0; i < 4; i++ {
+		if _, err := sem.acquire(context.Background()); err != nil {
+			t.Fatalf("initial acquire failed: %v", err)
+		}
+	}
+
+	var admitted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sem.acquire(context.Background()); err != nil {
+				t.Errorf("waiter failed: %v", err)
+				return
+			}
+			admitted.Add(1)
+		}()
+	}
+	deadline := time.After(2 * time.Second)
+	for sem.depth() < 4 {
+		select {
+		case <-deadline:
+			t.Fatal("waiters never queued")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	// Shrink while saturated, then drain the original holders. Only two
+	// waiters may be admitted; the released slots above the new capacity
+	// must be retired, not handed off.
+	sem.resize(2)
+	for i := 0; i < 4; i++ {
+		sem.release()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if got := admitted.Load(); got != 2 {
+		t.Errorf("admitted after shrink = %d, want 2", got)
+	}
+	if got := sem.depth(); got != 2 {
+		t.Errorf("depth after shrink = %d, want 2 still queued", got)
+	}
+
+	// Releasing within the new capacity hands off again.
+	sem.release()
+	sem.release()
+	wg.Wait()
+	if got := admitted.Load(); got != 4 {
+		t.Errorf("admitted after draining = %d, want 4", got)
+	}
+}
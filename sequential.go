@@ -0,0 +1,62 @@
+package relayer
+
+import "context"
+
+// SequentialOption configures ExecuteBatchSequential.
+type SequentialOption func(*sequentialConfig)
+
+type sequentialConfig struct {
+	stopOnError bool
+}
+
+// StopOnError makes ExecuteBatchSequential skip all remaining requests
+// after the first non-2xx response. Skipped requests receive a 424
+// response so the result slice still lines up with the batch.
+func StopOnError() SequentialOption {
+	return func(c *sequentialConfig) {
+		c.stopOnError = true
+	}
+}
+
+// ExecuteBatchSequential runs the batch strictly in input order on the
+// calling goroutine, one request at a time. Use it when later requests
+// depend on the side effects of earlier ones but a full dependency graph
+// is overkill. With StopOnError, requests after the first failure are
+// not executed and report 424 (Failed Dependency).
+//
+// Example:
+//
+//	results := orch.ExecuteBatchSequential(ctx, batch, relayer.StopOnError())
+func (o *Orchestrator) ExecuteBatchSequential(ctx context.Context, batch []SubRequest, opts ...SequentialOption) []Response {
+	if rejected, ok := o.admitBatch(batch); !ok {
+		return rejected
+	}
+
+	ctx = ensureBatchID(ctx)
+
+	var cfg sequentialConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	results := make([]Response, len(batch))
+	for i, req := range batch {
+		o.processRequest(ctx, req, &results[i])
+
+		if cfg.stopOnError && (results[i].Status < 200 || results[i].Status >= 300) {
+			for j := i + 1; j < len(batch); j++ {
+				results[j] = Response{
+					ID:       batch[j].ID,
+					Status:   424,
+					TenantID: batch[j].TenantID,
+					Error: &Error{
+						Code:    ErrCodeSkipped,
+						Message: "request skipped: an earlier request in the batch failed",
+					},
+				}
+			}
+			break
+		}
+	}
+	return results
+}
@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestExecuteBatchSequential_InputOrder(t *testing.T) {
+	orch := New()
+
+	var mu sync.Mutex
+	var order []string
+
+	orch.RegisterRecipe("record", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		order = append(order, payload.(string))
+		mu.Unlock()
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatchSequential(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "record", Payload: "a"},
+		{ID: "2", TenantID: "t", Recipe: "record", Payload: "b"},
+		{ID: "3", TenantID: "t", Recipe: "record", Payload: "c"},
+	})
+
+	if len(order) != 3 || order[0] != "a" || order[1] != "b" || order[2] != "c" {
+		t.Errorf("execution order = %v, want [a b c]", order)
+	}
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Errorf("results[%d].Status = %d, want 200", i, resp.Status)
+		}
+	}
+}
+
+func TestExecuteBatchSequential_StopOnError(t *testing.T) {
+	orch := New()
+
+	executed := 0
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed++
+		return nil, nil
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed++
+		return nil, errors.New("boom")
+	})
+
+	results := orch.ExecuteBatchSequential(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+		{ID: "2", TenantID: "t", Recipe: "fail"},
+		{ID: "3", TenantID: "t", Recipe: "ok"},
+	}, StopOnError())
+
+	if executed != 2 {
+		t.Errorf("executed %d handlers, want 2 (third skipped)", executed)
+	}
+
+	if results[2].Status != 424 {
+		t.Errorf("results[2].Status = %d, want 424", results[2].Status)
+	}
+	if !errors.Is(results[2].Error, ErrSkipped) {
+		t.Errorf("results[2].Error = %+v, want ErrSkipped match", results[2].Error)
+	}
+	if results[2].ID != "3" {
+		t.Errorf("results[2].ID = %q, want 3", results[2].ID)
+	}
+}
+
+func TestExecuteBatchSequential_ContinuesByDefault(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "done", nil
+	})
+
+	results := orch.ExecuteBatchSequential(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fail"},
+		{ID: "2", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[1].Status != 200 {
+		t.Errorf("results[1].Status = %d, want 200 without StopOnError", results[1].Status)
+	}
+}
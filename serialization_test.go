@@ -0,0 +1,89 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestSerializationKey_SequentialPerKey(t *testing.T) {
+	orch := New()
+
+	var mu sync.Mutex
+	order := map[string][]int{}
+	running := map[string]bool{}
+
+	orch.RegisterRecipe("rmw", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		info, _ := RequestInfoFromContext(ctx)
+		p := payload.(map[string]interface{})
+		key := p["key"].(string)
+		seq := int(p["seq"].(float64))
+		_ = info
+
+		mu.Lock()
+		if running[key] {
+			mu.Unlock()
+			t.Errorf("two requests with key %q ran concurrently", key)
+			return nil, nil
+		}
+		running[key] = true
+		order[key] = append(order[key], seq)
+		mu.Unlock()
+
+		mu.Lock()
+		running[key] = false
+		mu.Unlock()
+		return nil, nil
+	})
+
+	var batch []SubRequest
+	for _, key := range []string{"acct-1", "acct-2"} {
+		for seq := 0; seq < 5; seq++ {
+			batch = append(batch, SubRequest{
+				ID:               "r",
+				TenantID:         "t",
+				Recipe:           "rmw",
+				SerializationKey: key,
+				Payload:          map[string]interface{}{"key": key, "seq": float64(seq)},
+			})
+		}
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Fatalf("results[%d].Status = %d, want 200", i, resp.Status)
+		}
+	}
+
+	for key, seqs := range order {
+		for i, seq := range seqs {
+			if seq != i {
+				t.Errorf("key %s execution order = %v, want input order", key, seqs)
+				break
+			}
+		}
+	}
+}
+
+func TestSerializationKey_ResultsInBatchOrder(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "a", SerializationKey: "k"},
+		{ID: "2", TenantID: "t", Recipe: "echo", Payload: "b"},
+		{ID: "3", TenantID: "t", Recipe: "echo", Payload: "c", SerializationKey: "k"},
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	for i, want := range []string{"a", "b", "c"} {
+		if results[i].Data != want {
+			t.Errorf("results[%d].Data = %v, want %q (position must match batch)", i, results[i].Data, want)
+		}
+	}
+}
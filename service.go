@@ -0,0 +1,114 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// contextType and errorType are the reflected types service methods are
+// matched against.
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// RegisterService registers every exported method of svc with the
+// signature
+//
+//	func (s *Service) Name(ctx context.Context, in T) (U, error)
+//
+// as a recipe named after the method. Payload conversion follows the
+// same rules as RegisterRecipeT: payloads already of type T pass
+// through, anything else is round-tripped through encoding/json, and
+// conversion failures produce a 400 with ErrCodeInvalidPayload. Methods
+// with other signatures are skipped. Returns the registered recipe
+// names. Panics if svc is nil or has no matching methods, since that
+// indicates a wiring mistake rather than a runtime condition.
+//
+// Example:
+//
+//	type UserService struct{ db *sql.DB }
+//
+//	func (s *UserService) GetUser(ctx context.Context, req GetUserRequest) (*User, error) { ... }
+//	func (s *UserService) ListUsers(ctx context.Context, req ListUsersRequest) ([]User, error) { ... }
+//
+//	names := orch.RegisterService(&UserService{db: db})
+//	// names == []string{"GetUser", "ListUsers"}
+func (o *Orchestrator) RegisterService(svc interface{}) []string {
+	if svc == nil {
+		panic("service cannot be nil")
+	}
+
+	v := reflect.ValueOf(svc)
+	t := v.Type()
+
+	var names []string
+	for i := 0; i < t.NumMethod(); i++ {
+		method := t.Method(i)
+		if !serviceMethod(method.Type) {
+			continue
+		}
+		o.RegisterRecipe(method.Name, serviceHandler(method.Name, v.Method(i)))
+		names = append(names, method.Name)
+	}
+
+	if len(names) == 0 {
+		panic(fmt.Sprintf("service %T has no methods matching func(context.Context, T) (U, error)", svc))
+	}
+	return names
+}
+
+// serviceMethod reports whether a method type (receiver already bound
+// out by reflect.Value.Method) matches func(ctx, T) (U, error).
+func serviceMethod(t reflect.Type) bool {
+	// t includes the receiver as In(0) when taken from reflect.Type.Method.
+	return t.NumIn() == 3 &&
+		t.NumOut() == 2 &&
+		t.In(1) == contextType &&
+		t.Out(1) == errorType
+}
+
+// serviceHandler adapts a bound service method to the Handler
+// signature, converting the raw payload to the method's input type.
+func serviceHandler(name string, fn reflect.Value) Handler {
+	inType := fn.Type().In(1)
+
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		in, err := decodeToType(name, payload, inType)
+		if err != nil {
+			return nil, err
+		}
+
+		out := fn.Call([]reflect.Value{reflect.ValueOf(ctx), in})
+		if !out[1].IsNil() {
+			return nil, out[1].Interface().(error)
+		}
+		return out[0].Interface(), nil
+	}
+}
+
+// decodeToType is the reflection counterpart of decodePayload: direct
+// assignment when the payload already has the right type, JSON
+// round-trip otherwise.
+func decodeToType(recipe string, payload interface{}, want reflect.Type) (reflect.Value, error) {
+	if payload == nil {
+		return reflect.Zero(want), nil
+	}
+
+	v := reflect.ValueOf(payload)
+	if v.Type().AssignableTo(want) {
+		return v, nil
+	}
+
+	ptr := reflect.New(want)
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return reflect.Value{}, &PayloadTypeError{Recipe: recipe, Want: want.String(), Err: err}
+	}
+	if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+		return reflect.Value{}, &PayloadTypeError{Recipe: recipe, Want: want.String(), Err: err}
+	}
+	return ptr.Elem(), nil
+}
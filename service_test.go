@@ -0,0 +1,82 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+)
+
+type svcGreetRequest struct {
+	Name string `json:"name"`
+}
+
+type testService struct{}
+
+func (s *testService) Greet(ctx context.Context, req svcGreetRequest) (string, error) {
+	return "hello " + req.Name, nil
+}
+
+func (s *testService) Fail(ctx context.Context, req svcGreetRequest) (string, error) {
+	return "", errors.New("service failure")
+}
+
+// NotARecipe has the wrong signature and must be skipped.
+func (s *testService) NotARecipe(name string) string { return name }
+
+func TestRegisterService_RegistersMatchingMethods(t *testing.T) {
+	orch := New()
+	names := orch.RegisterService(&testService{})
+
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "Fail" || names[1] != "Greet" {
+		t.Fatalf("RegisterService() = %v, want [Fail Greet]", names)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "Greet", Payload: map[string]interface{}{"name": "ada"}},
+		{ID: "2", TenantID: "t", Recipe: "NotARecipe"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "hello ada" {
+		t.Errorf("Greet got (%d, %v), want (200, hello ada)", results[0].Status, results[0].Data)
+	}
+	if results[1].Status != 404 {
+		t.Errorf("NotARecipe Status = %d, want 404 (skipped during discovery)", results[1].Status)
+	}
+}
+
+func TestRegisterService_MethodErrorsBecome500s(t *testing.T) {
+	orch := New()
+	orch.RegisterService(&testService{})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "Fail"},
+	})
+
+	if results[0].Status != 500 || results[0].Error == nil {
+		t.Errorf("got (%d, %v), want a 500 with an error", results[0].Status, results[0].Error)
+	}
+}
+
+func TestRegisterService_BadPayloadIs400(t *testing.T) {
+	orch := New()
+	orch.RegisterService(&testService{})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "Greet", Payload: []int{1, 2, 3}},
+	})
+
+	if results[0].Status != 400 || results[0].Error.Code != ErrCodeInvalidPayload {
+		t.Errorf("got (%d, %v), want a 400 INVALID_PAYLOAD", results[0].Status, results[0].Error)
+	}
+}
+
+func TestRegisterService_NoMatchingMethodsPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for a service with no matching methods")
+		}
+	}()
+	New().RegisterService(struct{}{})
+}
@@ -0,0 +1,109 @@
+package relayer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LoadSheddingConfig controls latency-based load shedding.
+// When the observed p99 request latency or p99 semaphore queue wait
+// crosses its threshold, requests at or below SheddablePriority are
+// rejected with a 503 response and a Retry-After hint instead of
+// queueing indefinitely, keeping accepted work within SLO during
+// overload.
+type LoadSheddingConfig struct {
+	P99Threshold       time.Duration // Shed when observed p99 latency exceeds this (0 = ignore)
+	QueueWaitThreshold time.Duration // Shed when observed p99 queue wait exceeds this (0 = ignore)
+	SheddablePriority  int           // Requests with Priority <= this may be shed (default 0)
+	RetryAfter         time.Duration // Retry-After hint in shed responses (default 1s)
+	MinSamples         int           // Samples required before shedding kicks in (default 20)
+}
+
+// loadShedder tracks recent latency and queue-wait observations and
+// decides whether incoming requests should be shed.
+type loadShedder struct {
+	cfg       LoadSheddingConfig
+	mu        sync.Mutex
+	latency   latencyWindow
+	queueWait latencyWindow
+}
+
+// WithLoadShedding enables latency-based load shedding.
+// Low-priority requests (SubRequest.Priority <= SheddablePriority) are
+// rejected with 503 when observed latency or queue wait crosses the
+// configured thresholds.
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithMaxConcurrency(100),
+//		relayer.WithLoadShedding(relayer.LoadSheddingConfig{
+//			P99Threshold:       500 * time.Millisecond,
+//			QueueWaitThreshold: 100 * time.Millisecond,
+//		}),
+//	)
+func WithLoadShedding(cfg LoadSheddingConfig) Option {
+	return func(o *Orchestrator) {
+		if cfg.RetryAfter <= 0 {
+			cfg.RetryAfter = time.Second
+		}
+		if cfg.MinSamples <= 0 {
+			cfg.MinSamples = 20
+		}
+		o.shedder = &loadShedder{cfg: cfg}
+	}
+}
+
+func (s *loadShedder) recordLatency(d time.Duration) {
+	s.mu.Lock()
+	s.latency.record(d)
+	s.mu.Unlock()
+}
+
+func (s *loadShedder) recordQueueWait(d time.Duration) {
+	s.mu.Lock()
+	s.queueWait.record(d)
+	s.mu.Unlock()
+}
+
+// shouldShed reports whether a request at the given priority should be
+// rejected, and the overload signal that triggered the decision.
+func (s *loadShedder) shouldShed(priority int) (bool, string) {
+	if priority > s.cfg.SheddablePriority {
+		return false, ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cfg.P99Threshold > 0 && s.latency.count >= s.cfg.MinSamples {
+		if p99 := s.latency.quantile(0.99); p99 > s.cfg.P99Threshold {
+			return true, fmt.Sprintf("p99 latency %v exceeds threshold %v", p99, s.cfg.P99Threshold)
+		}
+	}
+
+	if s.cfg.QueueWaitThreshold > 0 && s.queueWait.count >= s.cfg.MinSamples {
+		if p99 := s.queueWait.quantile(0.99); p99 > s.cfg.QueueWaitThreshold {
+			return true, fmt.Sprintf("p99 queue wait %v exceeds threshold %v", p99, s.cfg.QueueWaitThreshold)
+		}
+	}
+
+	return false, ""
+}
+
+// shedResponse builds the 503 response for a shed request.
+func (s *loadShedder) shedResponse(req SubRequest, reason string) Response {
+	return Response{
+		ID:       req.ID,
+		Status:   503,
+		TenantID: req.TenantID,
+		Error: &Error{
+			Code:    ErrCodeOverloaded,
+			Message: "request shed due to overload: " + reason,
+			Details: map[string]interface{}{
+				"retry_after_ms": s.cfg.RetryAfter.Milliseconds(),
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLoadShedding_ShedsLowPriorityWhenOverloaded(t *testing.T) {
+	orch := New(WithLoadShedding(LoadSheddingConfig{
+		P99Threshold: 10 * time.Millisecond,
+		MinSamples:   5,
+	}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	// Seed the latency window above the threshold.
+	for i := 0; i < 10; i++ {
+		orch.shedder.recordLatency(50 * time.Millisecond)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Priority: 0},
+	})
+
+	if results[0].Status != 503 {
+		t.Fatalf("Status = %d, want 503", results[0].Status)
+	}
+
+	if results[0].Error.Code != ErrCodeOverloaded {
+		t.Errorf("Error code = %s, want %s", results[0].Error.Code, ErrCodeOverloaded)
+	}
+
+	if _, ok := results[0].Error.Details["retry_after_ms"]; !ok {
+		t.Error("Expected retry_after_ms hint in error details")
+	}
+}
+
+func TestLoadShedding_HighPrioritySurvives(t *testing.T) {
+	orch := New(WithLoadShedding(LoadSheddingConfig{
+		P99Threshold: 10 * time.Millisecond,
+		MinSamples:   5,
+	}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	for i := 0; i < 10; i++ {
+		orch.shedder.recordLatency(50 * time.Millisecond)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "vip", Priority: 1},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 (high priority should not be shed)", results[0].Status)
+	}
+}
+
+func TestLoadShedding_NoShedBelowThreshold(t *testing.T) {
+	orch := New(WithLoadShedding(LoadSheddingConfig{
+		P99Threshold: time.Second,
+		MinSamples:   5,
+	}))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	for i := 0; i < 10; i++ {
+		orch.shedder.recordLatency(time.Millisecond)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
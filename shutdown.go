@@ -0,0 +1,50 @@
+package relayer
+
+import "context"
+
+// Shutdown gracefully stops the orchestrator. New batches are refused
+// with a 503/SHUTTING_DOWN response immediately; in-flight requests are
+// given until the context deadline to finish, after which their contexts
+// are cancelled and Shutdown waits for them to unwind. Returns nil if
+// everything drained in time, or the context's error if stragglers had
+// to be cancelled. Safe to call multiple times.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := orch.Shutdown(ctx); err != nil {
+//		log.Printf("forced shutdown: %v", err)
+//	}
+func (o *Orchestrator) Shutdown(ctx context.Context) error {
+	first := o.closed.CompareAndSwap(false, true)
+
+	err := o.Drain(ctx)
+	if err != nil {
+		// Cancel straggler contexts, then wait for them to unwind so the
+		// caller can exit without leaking handler goroutines.
+		o.shutdownCancel()
+		<-o.inflight.drained()
+	}
+
+	// Stop pooled workers once everything has drained or unwound.
+	if first {
+		if pool, ok := o.scheduler.(*workerPool); ok {
+			pool.stop()
+		}
+	}
+
+	return err
+}
+
+// Drain waits for all in-flight requests to complete, up to the context
+// deadline, without refusing new work. Useful before a config reload or
+// registry swap when the process should keep serving.
+func (o *Orchestrator) Drain(ctx context.Context) error {
+	select {
+	case <-o.inflight.drained():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
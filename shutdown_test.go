@@ -0,0 +1,127 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShutdown_RefusesNewBatches(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "fine", nil
+	})
+
+	if err := orch.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown with no in-flight work failed: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].Status != 503 {
+		t.Errorf("Status after shutdown = %d, want 503", results[0].Status)
+	}
+	if !errors.Is(results[0].Error, ErrShuttingDown) {
+		t.Errorf("Error = %+v, want ErrShuttingDown match", results[0].Error)
+	}
+}
+
+func TestShutdown_WaitsForInFlight(t *testing.T) {
+	orch := New()
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-release
+		return "done", nil
+	})
+
+	var wg sync.WaitGroup
+	var results []Response
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results = orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "slow"},
+		})
+	}()
+
+	// Give the batch time to start executing
+	time.Sleep(50 * time.Millisecond)
+
+	shutdownDone := make(chan error, 1)
+	go func() {
+		shutdownDone <- orch.Shutdown(context.Background())
+	}()
+
+	select {
+	case <-shutdownDone:
+		t.Fatal("Shutdown returned before in-flight request completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	if err := <-shutdownDone; err != nil {
+		t.Errorf("Shutdown = %v, want nil after drain", err)
+	}
+
+	wg.Wait()
+	if results[0].Status != 200 {
+		t.Errorf("In-flight request status = %d, want 200", results[0].Status)
+	}
+}
+
+func TestShutdown_CancelsStragglers(t *testing.T) {
+	orch := New(WithTimeout(10 * time.Second))
+
+	orch.RegisterRecipe("stuck", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "stuck"},
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := orch.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown = %v, want DeadlineExceeded", err)
+	}
+
+	// The straggler's context was cancelled, so the batch must unwind.
+	wg.Wait()
+}
+
+func TestDrain_AllowsNewWork(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "fine", nil
+	})
+
+	if err := orch.Drain(context.Background()); err != nil {
+		t.Fatalf("Drain failed: %v", err)
+	}
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status after Drain = %d, want 200 (Drain must not refuse work)", results[0].Status)
+	}
+}
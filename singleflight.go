@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+)
+
+// singleflightGroup collapses concurrent executions with the same key
+// into one handler call whose result is shared by all waiters.
+type singleflightGroup struct {
+	keyFunc DedupKeyFunc
+
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done chan struct{}
+	data interface{}
+	err  error
+}
+
+// WithSingleflight collapses identical in-flight requests across
+// *different* batches into a single handler execution, keyed by keyFunc
+// (nil = DefaultDedupKey: tenant + recipe + payload hash). Useful for hot
+// keys like "get-config" recipes hit by many tenants simultaneously.
+//
+// The shared execution runs under the context of the request that
+// initiated it; waiters receive the same data and error. Only use this
+// for idempotent recipes whose result does not depend on caller identity
+// beyond the dedup key.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithSingleflight(nil))
+func WithSingleflight(keyFunc DedupKeyFunc) Option {
+	return func(o *Orchestrator) {
+		if keyFunc == nil {
+			keyFunc = DefaultDedupKey
+		}
+		o.singleflight = &singleflightGroup{
+			keyFunc: keyFunc,
+			calls:   make(map[string]*flightCall),
+		}
+	}
+}
+
+// wrap decorates a handler so concurrent calls with the same key share
+// one execution.
+func (g *singleflightGroup) wrap(req SubRequest, handler Handler) Handler {
+	key := g.keyFunc(req)
+	return func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return g.do(key, ctx, payload, handler)
+	}
+}
+
+func (g *singleflightGroup) do(key string, ctx context.Context, payload interface{}, handler Handler) (interface{}, error) {
+	g.mu.Lock()
+	if call, inFlight := g.calls[key]; inFlight {
+		g.mu.Unlock()
+		// Wait for the leader, but respect this caller's own deadline.
+		select {
+		case <-call.done:
+			return call.data, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	// Complete the call even if the handler panics, so waiters never
+	// hang; the panic then propagates to the leader's panic recovery.
+	completed := false
+	defer func() {
+		if !completed {
+			call.err = &panicError{}
+		}
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.data, call.err = handler(ctx, payload)
+	completed = true
+	return call.data, call.err
+}
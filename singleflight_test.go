@@ -0,0 +1,102 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSingleflight_SharesExecutionAcrossBatches(t *testing.T) {
+	orch := New(WithSingleflight(nil))
+
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	orch.RegisterRecipe("get-config", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+		<-release
+		return "config-v1", nil
+	})
+
+	req := SubRequest{ID: "1", TenantID: "tenant-a", Recipe: "get-config", Payload: "key"}
+
+	var wg sync.WaitGroup
+	results := make([][]Response, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r := req
+			r.ID = r.ID + string(rune('a'+i))
+			results[i] = orch.ExecuteBatch(context.Background(), []SubRequest{r})
+		}(i)
+	}
+
+	// Wait for the leader to start, give followers time to pile up, then
+	// release the shared execution.
+	<-started
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Handler called %d times, want 1", n)
+	}
+
+	for i, r := range results {
+		if r[0].Status != 200 || r[0].Data != "config-v1" {
+			t.Errorf("Batch %d result = %+v, want shared 200/config-v1", i, r[0])
+		}
+	}
+}
+
+func TestSingleflight_DifferentKeysRunIndependently(t *testing.T) {
+	orch := New(WithSingleflight(nil))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	var wg sync.WaitGroup
+	for _, payload := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(p string) {
+			defer wg.Done()
+			orch.ExecuteBatch(context.Background(), []SubRequest{
+				{ID: p, TenantID: "t", Recipe: "echo", Payload: p},
+			})
+		}(payload)
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Handler called %d times, want 2", n)
+	}
+}
+
+func TestSingleflight_SequentialCallsNotShared(t *testing.T) {
+	orch := New(WithSingleflight(nil))
+
+	var calls int32
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return payload, nil
+	})
+
+	batch := []SubRequest{{ID: "1", TenantID: "t", Recipe: "echo", Payload: "x"}}
+
+	orch.ExecuteBatch(context.Background(), batch)
+	orch.ExecuteBatch(context.Background(), batch)
+
+	// Sequential executions never overlap, so both run the handler.
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Handler called %d times, want 2", n)
+	}
+}
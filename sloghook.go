@@ -0,0 +1,122 @@
+package relayer
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// SlogHook is a ready-made ExecutionHook and PanicHook logging to
+// log/slog with structured fields: tenant, recipe, request ID, trace
+// ID, status, and duration. Install it with both WithExecutionHook and
+// WithPanicHook to cover panics too.
+//
+// Example:
+//
+//	hook := relayer.NewSlogHook(slog.Default())
+//	orch := relayer.New(
+//		relayer.WithExecutionHook(hook),
+//		relayer.WithPanicHook(hook),
+//	)
+type SlogHook struct {
+	logger        *slog.Logger
+	startLevel    slog.Level
+	completeLevel slog.Level
+	errorLevel    slog.Level
+}
+
+// SlogHookOption configures a SlogHook.
+type SlogHookOption func(*SlogHook)
+
+// WithSlogStartLevel sets the level for request-start events
+// (default slog.LevelDebug).
+func WithSlogStartLevel(level slog.Level) SlogHookOption {
+	return func(h *SlogHook) { h.startLevel = level }
+}
+
+// WithSlogCompleteLevel sets the level for successful completions
+// (default slog.LevelInfo).
+func WithSlogCompleteLevel(level slog.Level) SlogHookOption {
+	return func(h *SlogHook) { h.completeLevel = level }
+}
+
+// WithSlogErrorLevel sets the level for failed completions and panics
+// (default slog.LevelError).
+func WithSlogErrorLevel(level slog.Level) SlogHookOption {
+	return func(h *SlogHook) { h.errorLevel = level }
+}
+
+// NewSlogHook creates a structured logging hook writing to logger. A
+// nil logger uses slog.Default().
+func NewSlogHook(logger *slog.Logger, opts ...SlogHookOption) *SlogHook {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	h := &SlogHook{
+		logger:        logger,
+		startLevel:    slog.LevelDebug,
+		completeLevel: slog.LevelInfo,
+		errorLevel:    slog.LevelError,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// OnStart logs the start of a recipe execution.
+func (h *SlogHook) OnStart(ctx context.Context, req SubRequest) {
+	h.logger.LogAttrs(ctx, h.startLevel, "recipe started",
+		slog.String("tenant", req.TenantID),
+		slog.String("recipe", req.Recipe),
+		slog.String("request_id", req.ID),
+		slog.String("trace_id", req.TraceID),
+	)
+}
+
+// OnComplete logs the completion, at the error level for failures.
+func (h *SlogHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	level := h.completeLevel
+	msg := "recipe completed"
+	attrs := []slog.Attr{
+		slog.String("tenant", req.TenantID),
+		slog.String("recipe", req.Recipe),
+		slog.String("request_id", req.ID),
+		slog.String("trace_id", req.TraceID),
+		slog.Int("status", resp.Status),
+		slog.Duration("duration", duration),
+	}
+	if resp.Error != nil {
+		level = h.errorLevel
+		msg = "recipe failed"
+		attrs = append(attrs,
+			slog.String("error_code", resp.Error.Code),
+			slog.String("error", resp.Error.Message),
+		)
+	}
+	h.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// OnPanic logs recovered panics at the error level.
+func (h *SlogHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	h.logger.LogAttrs(ctx, h.errorLevel, "recipe panicked",
+		slog.String("tenant", req.TenantID),
+		slog.String("recipe", req.Recipe),
+		slog.String("request_id", req.ID),
+		slog.String("trace_id", req.TraceID),
+		slog.Any("panic", recovered),
+	)
+}
+
+// OnPanicWithStack logs recovered panics at the error level, including
+// the stack captured at the panic site.
+func (h *SlogHook) OnPanicWithStack(ctx context.Context, req SubRequest, recovered interface{}, stack []byte) {
+	h.logger.LogAttrs(ctx, h.errorLevel, "recipe panicked",
+		slog.String("tenant", req.TenantID),
+		slog.String("recipe", req.Recipe),
+		slog.String("request_id", req.ID),
+		slog.String("trace_id", req.TraceID),
+		slog.Any("panic", recovered),
+		slog.String("stack", string(stack)),
+	)
+}
@@ -0,0 +1,82 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogHook_LogsCompletionFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	hook := NewSlogHook(logger)
+
+	orch := New(WithExecutionHook(hook), WithPanicHook(hook))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "req-1", TenantID: "acme", Recipe: "ok"},
+	})
+
+	out := buf.String()
+	for _, want := range []string{
+		"recipe started",
+		"recipe completed",
+		`"tenant":"acme"`,
+		`"recipe":"ok"`,
+		`"request_id":"req-1"`,
+		`"status":200`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSlogHook_FailuresAndPanicsAtErrorLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	hook := NewSlogHook(logger)
+
+	orch := New(WithExecutionHook(hook), WithPanicHook(hook))
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+	orch.RegisterRecipe("explode", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		panic("kaboom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "bad"},
+		{ID: "2", TenantID: "t", Recipe: "explode"},
+	})
+
+	out := buf.String()
+	if !strings.Contains(out, "recipe failed") || !strings.Contains(out, `"error_code":"RECIPE_EXECUTION"`) {
+		t.Errorf("failure log missing error fields:\n%s", out)
+	}
+	if !strings.Contains(out, "recipe panicked") || !strings.Contains(out, `"panic":"kaboom"`) {
+		t.Errorf("panic log missing panic field:\n%s", out)
+	}
+	if strings.Contains(out, `"level":"INFO","msg":"recipe failed"`) {
+		t.Errorf("failures should log at the error level:\n%s", out)
+	}
+}
+
+func TestSlogHook_ConfigurableLevels(t *testing.T) {
+	var buf bytes.Buffer
+	// Handler at INFO: a start event raised to INFO must appear.
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	hook := NewSlogHook(logger, WithSlogStartLevel(slog.LevelInfo))
+
+	hook.OnStart(context.Background(), SubRequest{ID: "1", TenantID: "t", Recipe: "r"})
+
+	if !strings.Contains(buf.String(), "recipe started") {
+		t.Errorf("start event at INFO level was not logged:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,129 @@
+package relayer
+
+import (
+	"sync"
+	"time"
+)
+
+// RecipeStats summarizes executions of a single recipe (or, for tenant
+// stats, a single tenant). Percentiles are computed over a sliding window
+// of recent samples.
+type RecipeStats struct {
+	Count     int64         `json:"count"`      // Total executions observed
+	Errors    int64         `json:"errors"`     // Executions with non-2xx status
+	ErrorRate float64       `json:"error_rate"` // Errors / Count
+	P50       time.Duration `json:"p50_ms"`
+	P95       time.Duration `json:"p95_ms"`
+	P99       time.Duration `json:"p99_ms"`
+}
+
+// Stats is a point-in-time snapshot of orchestrator statistics, keyed by
+// recipe name and tenant ID.
+type Stats struct {
+	Recipes map[string]RecipeStats `json:"recipes"`
+	Tenants map[string]RecipeStats `json:"tenants"`
+}
+
+// statsEntry accumulates counts and a latency window for one key.
+type statsEntry struct {
+	count  int64
+	errors int64
+	window latencyWindow
+}
+
+// StatsCollector maintains per-recipe and per-tenant execution counts,
+// error rates, and latency percentiles. Enable it with WithStats and read
+// it with Orchestrator.Stats.
+type StatsCollector struct {
+	mu      sync.Mutex
+	recipes map[string]*statsEntry
+	tenants map[string]*statsEntry
+}
+
+func newStatsCollector() *StatsCollector {
+	return &StatsCollector{
+		recipes: make(map[string]*statsEntry),
+		tenants: make(map[string]*statsEntry),
+	}
+}
+
+func (c *StatsCollector) record(recipe, tenantID string, d time.Duration, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recordEntry(c.recipes, recipe, d, failed)
+	c.recordEntry(c.tenants, tenantID, d, failed)
+}
+
+func (c *StatsCollector) recordEntry(entries map[string]*statsEntry, key string, d time.Duration, failed bool) {
+	entry, exists := entries[key]
+	if !exists {
+		entry = &statsEntry{}
+		entries[key] = entry
+	}
+	entry.count++
+	if failed {
+		entry.errors++
+	}
+	entry.window.record(d)
+}
+
+// snapshot converts accumulated entries into exported stats.
+func (c *StatsCollector) snapshot() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := Stats{
+		Recipes: make(map[string]RecipeStats, len(c.recipes)),
+		Tenants: make(map[string]RecipeStats, len(c.tenants)),
+	}
+	for name, entry := range c.recipes {
+		stats.Recipes[name] = entry.stats()
+	}
+	for tenant, entry := range c.tenants {
+		stats.Tenants[tenant] = entry.stats()
+	}
+	return stats
+}
+
+func (e *statsEntry) stats() RecipeStats {
+	s := RecipeStats{
+		Count:  e.count,
+		Errors: e.errors,
+		P50:    e.window.quantile(0.50),
+		P95:    e.window.quantile(0.95),
+		P99:    e.window.quantile(0.99),
+	}
+	if e.count > 0 {
+		s.ErrorRate = float64(e.errors) / float64(e.count)
+	}
+	return s
+}
+
+// WithStats enables the built-in statistics collector. Per-recipe and
+// per-tenant counts, error rates, and latency percentiles become
+// available via Orchestrator.Stats, so services can expose health
+// dashboards without external metrics infrastructure.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithStats())
+//	...
+//	stats := orch.Stats()
+//	fmt.Printf("get-user p99: %v\n", stats.Recipes["get-user"].P99)
+func WithStats() Option {
+	return func(o *Orchestrator) {
+		o.stats = newStatsCollector()
+	}
+}
+
+// Stats returns a snapshot of collected statistics. Returns zero-valued
+// Stats (with non-nil maps) when WithStats was not enabled.
+func (o *Orchestrator) Stats() Stats {
+	if o.stats == nil {
+		return Stats{
+			Recipes: make(map[string]RecipeStats),
+			Tenants: make(map[string]RecipeStats),
+		}
+	}
+	return o.stats.snapshot()
+}
@@ -0,0 +1,94 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithStats_CountsAndErrorRate(t *testing.T) {
+	orch := New(WithStats())
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(time.Millisecond)
+		return "fine", nil
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "a", Recipe: "ok"},
+		{ID: "2", TenantID: "a", Recipe: "ok"},
+		{ID: "3", TenantID: "b", Recipe: "fail"},
+	})
+
+	stats := orch.Stats()
+
+	okStats := stats.Recipes["ok"]
+	if okStats.Count != 2 {
+		t.Errorf("ok.Count = %d, want 2", okStats.Count)
+	}
+	if okStats.ErrorRate != 0 {
+		t.Errorf("ok.ErrorRate = %v, want 0", okStats.ErrorRate)
+	}
+	if okStats.P50 <= 0 {
+		t.Error("ok.P50 should be positive")
+	}
+
+	failStats := stats.Recipes["fail"]
+	if failStats.Count != 1 || failStats.Errors != 1 {
+		t.Errorf("fail stats = %+v, want count 1, errors 1", failStats)
+	}
+	if failStats.ErrorRate != 1.0 {
+		t.Errorf("fail.ErrorRate = %v, want 1.0", failStats.ErrorRate)
+	}
+
+	if stats.Tenants["a"].Count != 2 {
+		t.Errorf("tenant a count = %d, want 2", stats.Tenants["a"].Count)
+	}
+	if stats.Tenants["b"].Errors != 1 {
+		t.Errorf("tenant b errors = %d, want 1", stats.Tenants["b"].Errors)
+	}
+}
+
+func TestStats_DisabledReturnsEmpty(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	stats := orch.Stats()
+	if stats.Recipes == nil || stats.Tenants == nil {
+		t.Fatal("Stats maps should be non-nil even when disabled")
+	}
+	if len(stats.Recipes) != 0 {
+		t.Errorf("Recipes = %v, want empty when stats disabled", stats.Recipes)
+	}
+}
+
+func TestStats_Percentiles(t *testing.T) {
+	c := newStatsCollector()
+
+	for i := 1; i <= 100; i++ {
+		c.record("r", "t", time.Duration(i)*time.Millisecond, false)
+	}
+
+	stats := c.snapshot()
+	r := stats.Recipes["r"]
+
+	if r.P50 < 40*time.Millisecond || r.P50 > 60*time.Millisecond {
+		t.Errorf("P50 = %v, want ~50ms", r.P50)
+	}
+	if r.P99 < 90*time.Millisecond {
+		t.Errorf("P99 = %v, want >= 90ms", r.P99)
+	}
+	if r.P95 > r.P99 {
+		t.Errorf("P95 (%v) > P99 (%v)", r.P95, r.P99)
+	}
+}
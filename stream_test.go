@@ -0,0 +1,105 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExecuteBatchStream_EmitsAllResponses(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo", Payload: "a"},
+		{ID: "2", TenantID: "t", Recipe: "echo", Payload: "b"},
+		{ID: "3", TenantID: "t", Recipe: "echo", Payload: "c"},
+	}
+
+	seen := make(map[string]Response)
+	for resp := range orch.ExecuteBatchStream(context.Background(), batch) {
+		seen[resp.ID] = resp
+	}
+
+	if len(seen) != 3 {
+		t.Fatalf("Received %d responses, want 3", len(seen))
+	}
+
+	for _, req := range batch {
+		resp, ok := seen[req.ID]
+		if !ok {
+			t.Errorf("No response for request %s", req.ID)
+			continue
+		}
+		if resp.Status != 200 {
+			t.Errorf("Request %s status = %d, want 200", req.ID, resp.Status)
+		}
+	}
+}
+
+func TestExecuteBatchStream_FastResultsArriveFirst(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("fast", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "fast", nil
+	})
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		time.Sleep(100 * time.Millisecond)
+		return "slow", nil
+	})
+
+	batch := []SubRequest{
+		{ID: "slow-1", TenantID: "t", Recipe: "slow"},
+		{ID: "fast-1", TenantID: "t", Recipe: "fast"},
+	}
+
+	stream := orch.ExecuteBatchStream(context.Background(), batch)
+
+	first := <-stream
+	if first.ID != "fast-1" {
+		t.Errorf("First streamed response = %s, want fast-1", first.ID)
+	}
+
+	second := <-stream
+	if second.ID != "slow-1" {
+		t.Errorf("Second streamed response = %s, want slow-1", second.ID)
+	}
+
+	if _, open := <-stream; open {
+		t.Error("Stream not closed after all responses")
+	}
+}
+
+func TestExecuteBatchStream_OversizedBatch(t *testing.T) {
+	orch := New(WithMaxBatchSize(1))
+
+	batch := []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "r"},
+		{ID: "2", TenantID: "t", Recipe: "r"},
+	}
+
+	count := 0
+	for resp := range orch.ExecuteBatchStream(context.Background(), batch) {
+		count++
+		if resp.Status != 413 {
+			t.Errorf("Status = %d, want 413", resp.Status)
+		}
+	}
+
+	if count != 2 {
+		t.Errorf("Received %d responses, want 2", count)
+	}
+}
+
+func TestExecuteBatchStream_EmptyBatch(t *testing.T) {
+	orch := New()
+
+	stream := orch.ExecuteBatchStream(context.Background(), nil)
+
+	if _, open := <-stream; open {
+		t.Error("Expected closed stream for empty batch")
+	}
+}
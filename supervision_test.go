@@ -0,0 +1,89 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHandlerSupervision_ReleasesSlotAtDeadline(t *testing.T) {
+	orch := New(
+		WithTimeout(20*time.Millisecond),
+		WithMaxConcurrency(1),
+		WithHandlerSupervision(),
+	)
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("runaway", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-release // ignores ctx
+		return nil, nil
+	})
+	orch.RegisterRecipe("quick", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	start := time.Now()
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "runaway"},
+		{ID: "2", TenantID: "t", Recipe: "quick"},
+	})
+	elapsed := time.Since(start)
+	close(release)
+
+	if results[0].Status != 504 {
+		t.Errorf("runaway Status = %d, want 504", results[0].Status)
+	}
+	if results[1].Status != 200 {
+		t.Errorf("quick Status = %d, want 200: the slot must be released at deadline", results[1].Status)
+	}
+	// Both requests share one slot; without supervision the batch would
+	// block until the runaway handler returned.
+	if elapsed > time.Second {
+		t.Errorf("batch took %v, want completion near the 20ms deadline", elapsed)
+	}
+}
+
+func TestLeakedHandlers_TracksDetachedGoroutines(t *testing.T) {
+	orch := New(WithTimeout(20*time.Millisecond), WithHandlerSupervision())
+
+	release := make(chan struct{})
+	orch.RegisterRecipe("runaway", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "runaway"},
+	})
+
+	if got := orch.LeakedHandlers(); got != 1 {
+		t.Errorf("LeakedHandlers() = %d, want 1 while the handler is stuck", got)
+	}
+
+	close(release)
+	deadline := time.Now().Add(2 * time.Second)
+	for orch.LeakedHandlers() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("LeakedHandlers() never returned to 0 after the handler finished")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHandlerSupervision_WellBehavedHandlersUnaffected(t *testing.T) {
+	orch := New(WithTimeout(time.Second), WithHandlerSupervision())
+	orch.RegisterRecipe("fine", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fine"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "ok" {
+		t.Errorf("got (%d, %v), want (200, ok)", results[0].Status, results[0].Data)
+	}
+	if got := orch.LeakedHandlers(); got != 0 {
+		t.Errorf("LeakedHandlers() = %d, want 0", got)
+	}
+}
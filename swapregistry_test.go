@@ -0,0 +1,78 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSwapRegistry_ReplacesEntireSet(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("old", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "old", nil
+	})
+
+	orch.SwapRegistry(map[string]Handler{
+		"new": func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return "new", nil
+		},
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "new"},
+		{ID: "2", TenantID: "t", Recipe: "old"},
+	})
+
+	if results[0].Status != 200 || results[0].Data != "new" {
+		t.Errorf("new recipe got (%d, %v), want (200, new)", results[0].Status, results[0].Data)
+	}
+	if results[1].Status != 404 {
+		t.Errorf("old recipe Status = %d, want 404 after swap", results[1].Status)
+	}
+}
+
+func TestSwapRegistry_PreservesOptionsForKeptNames(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("kept", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	}, &RecipeOption{Description: "survives the swap"})
+
+	orch.SwapRegistry(map[string]Handler{
+		"kept": func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	})
+
+	infos := orch.ListRecipes()
+	if len(infos) != 1 || infos[0].Description != "survives the swap" {
+		t.Errorf("ListRecipes() = %+v, want the kept recipe with its description", infos)
+	}
+}
+
+func TestSwapRegistry_CopiesCallerMap(t *testing.T) {
+	orch := New()
+	recipes := map[string]Handler{
+		"stable": func(ctx context.Context, payload interface{}) (interface{}, error) {
+			return "ok", nil
+		},
+	}
+	orch.SwapRegistry(recipes)
+
+	// Mutating the caller's map must not affect the live registry.
+	delete(recipes, "stable")
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "stable"},
+	})
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200 from the copied registry", results[0].Status)
+	}
+}
+
+func TestSwapRegistry_NilHandlerPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for nil handler")
+		}
+	}()
+	New().SwapRegistry(map[string]Handler{"bad": nil})
+}
@@ -0,0 +1,27 @@
+package relayer
+
+// synchronousScheduler runs each task inline on the scheduling
+// goroutine, so a batch executes sequentially in input order.
+type synchronousScheduler struct{}
+
+func (synchronousScheduler) Schedule(task func()) { task() }
+
+// WithSynchronousExecution runs each batch sequentially in input order
+// on the goroutine that called ExecuteBatch, instead of one goroutine
+// per request. Combined with WithClock and a fake clock this makes
+// batch behavior fully deterministic, which is what golden-file tests
+// need. Requests sharing a SerializationKey are still grouped as usual.
+// Not intended for production: batch latency becomes the sum of its
+// requests.
+//
+// Example:
+//
+//	orch := relayer.New(
+//		relayer.WithSynchronousExecution(),
+//		relayer.WithClock(fakeClock),
+//	)
+func WithSynchronousExecution() Option {
+	return func(o *Orchestrator) {
+		o.scheduler = synchronousScheduler{}
+	}
+}
@@ -0,0 +1,57 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestWithSynchronousExecution_RunsInInputOrder(t *testing.T) {
+	orch := New(WithSynchronousExecution())
+
+	var mu sync.Mutex
+	var order []string
+	orch.RegisterRecipe("step", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		order = append(order, payload.(string))
+		mu.Unlock()
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "step", Payload: "a"},
+		{ID: "2", TenantID: "t", Recipe: "step", Payload: "b"},
+		{ID: "3", TenantID: "t", Recipe: "step", Payload: "c"},
+	})
+
+	want := []string{"a", "b", "c"}
+	for i, v := range want {
+		if order[i] != v {
+			t.Fatalf("execution order = %v, want %v", order, want)
+		}
+		if results[i].Data != v {
+			t.Errorf("results[%d].Data = %v, want %s", i, results[i].Data, v)
+		}
+	}
+}
+
+func TestWithSynchronousExecution_RunsOnCallingGoroutine(t *testing.T) {
+	orch := New(WithSynchronousExecution())
+
+	var handlerGoroutine uint64
+	orch.RegisterRecipe("probe", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		handlerGoroutine = goroutineID()
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "probe"},
+	})
+
+	if handlerGoroutine == 0 {
+		t.Fatal("handler never ran")
+	}
+	if caller := goroutineID(); handlerGoroutine != caller {
+		t.Errorf("handler ran on goroutine %d, want caller's %d", handlerGoroutine, caller)
+	}
+}
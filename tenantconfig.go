@@ -0,0 +1,117 @@
+package relayer
+
+import "time"
+
+// TenantConfig holds per-tenant overrides of orchestrator limits. Zero
+// fields fall back to the orchestrator-wide configuration, so providers
+// only need to populate what differs for a tenant.
+type TenantConfig struct {
+	// Timeout overrides the recipe and default timeouts when > 0.
+	Timeout time.Duration
+
+	// MaxConcurrency caps the tenant's concurrently executing requests
+	// when > 0, independent of the global and per-recipe limits. The
+	// cap is applied when the tenant's first request arrives; later
+	// changes to this field do not resize it.
+	MaxConcurrency int
+
+	// RateLimitRPS overrides the tenant's request rate when > 0, with
+	// RateLimitBurst as the burst capacity (minimum 1). Effective even
+	// when WithTenantRateLimit is not configured.
+	RateLimitRPS   float64
+	RateLimitBurst int
+
+	// AllowedRecipes restricts the tenant to these recipes when
+	// non-nil; requests for others fail with 403 and reach the audit
+	// hook. Nil leaves recipe access to the ACL provider, if any.
+	AllowedRecipes []string
+}
+
+// recipeAllowed reports whether the config admits the recipe.
+func (c TenantConfig) recipeAllowed(recipe string) bool {
+	if c.AllowedRecipes == nil {
+		return true
+	}
+	for _, allowed := range c.AllowedRecipes {
+		if allowed == recipe {
+			return true
+		}
+	}
+	return false
+}
+
+// TenantConfigProvider supplies per-tenant overrides, consulted once
+// per request. Return false to use the orchestrator-wide defaults for
+// the tenant. Implementations must be safe for concurrent use; back
+// them with a cache when the lookup is remote.
+type TenantConfigProvider interface {
+	TenantConfig(tenantID string) (TenantConfig, bool)
+}
+
+// TenantConfigProviderFunc adapts a plain function to the
+// TenantConfigProvider interface.
+type TenantConfigProviderFunc func(tenantID string) (TenantConfig, bool)
+
+// TenantConfig implements TenantConfigProvider.
+func (f TenantConfigProviderFunc) TenantConfig(tenantID string) (TenantConfig, bool) {
+	return f(tenantID)
+}
+
+// staticTenantConfigs is the provider behind StaticTenantConfigs.
+type staticTenantConfigs map[string]TenantConfig
+
+func (s staticTenantConfigs) TenantConfig(tenantID string) (TenantConfig, bool) {
+	cfg, ok := s[tenantID]
+	return cfg, ok
+}
+
+// StaticTenantConfigs builds a TenantConfigProvider from a fixed map,
+// for deployments whose tenant tiers are known at startup.
+func StaticTenantConfigs(configs map[string]TenantConfig) TenantConfigProvider {
+	return staticTenantConfigs(configs)
+}
+
+// WithTenantConfigProvider sets the source of per-tenant overrides
+// (timeout, concurrency, rate limit, allowed recipes), letting SaaS
+// deployments tune limits per customer without code changes. Panics if
+// provider is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithTenantConfigProvider(relayer.StaticTenantConfigs(
+//		map[string]relayer.TenantConfig{
+//			"tenant-free": {MaxConcurrency: 2, RateLimitRPS: 10, RateLimitBurst: 5},
+//			"tenant-pro":  {MaxConcurrency: 50, Timeout: 30 * time.Second},
+//		},
+//	)))
+func WithTenantConfigProvider(provider TenantConfigProvider) Option {
+	return func(o *Orchestrator) {
+		if provider == nil {
+			panic("tenant config provider cannot be nil")
+		}
+		o.tenantConfigs = provider
+	}
+}
+
+// tenantSemaphore returns the tenant's concurrency semaphore, creating
+// it with the given capacity on first use. Returns nil when the tenant
+// has no concurrency cap.
+func (o *Orchestrator) tenantSemaphore(tenantID string, limit int) chan struct{} {
+	if limit <= 0 {
+		return nil
+	}
+	o.mu.RLock()
+	sem := o.tenantSemaphores[tenantID]
+	o.mu.RUnlock()
+	if sem != nil {
+		return sem
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if sem = o.tenantSemaphores[tenantID]; sem == nil {
+		sem = make(chan struct{}, limit)
+		o.tenantSemaphores[tenantID] = sem
+	}
+	return sem
+}
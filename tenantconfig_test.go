@@ -0,0 +1,139 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTenantConfig_TimeoutOverride(t *testing.T) {
+	orch := New(
+		WithTimeout(time.Second),
+		WithTenantConfigProvider(StaticTenantConfigs(map[string]TenantConfig{
+			"tenant-tight": {Timeout: 20 * time.Millisecond},
+		})),
+	)
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-tight", Recipe: "slow"},
+	})
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeTimeout {
+		t.Fatalf("Error = %v, want %s", results[0].Error, ErrCodeTimeout)
+	}
+	if got := results[0].Error.Details["timeout_source"]; got != "tenant" {
+		t.Errorf("timeout_source = %v, want tenant", got)
+	}
+	if got := results[0].Error.Details["timeout_ms"]; got != float64(20) {
+		t.Errorf("timeout_ms = %v, want 20", got)
+	}
+}
+
+func TestTenantConfig_AllowedRecipes(t *testing.T) {
+	orch := New(WithTenantConfigProvider(StaticTenantConfigs(map[string]TenantConfig{
+		"tenant-free": {AllowedRecipes: []string{"get-user"}},
+	})))
+	orch.RegisterRecipe("get-user", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "user", nil
+	})
+	orch.RegisterRecipe("bulk-export", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "export", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-free", Recipe: "get-user"},
+		{ID: "2", TenantID: "tenant-free", Recipe: "bulk-export"},
+		{ID: "3", TenantID: "tenant-other", Recipe: "bulk-export"},
+	})
+
+	byID := make(map[string]Response, len(results))
+	for _, r := range results {
+		byID[r.ID] = r
+	}
+	if byID["1"].Status != 200 {
+		t.Errorf("allowed recipe: Status = %d, want 200", byID["1"].Status)
+	}
+	if byID["2"].Status != 403 {
+		t.Errorf("restricted recipe: Status = %d, want 403", byID["2"].Status)
+	}
+	if byID["3"].Status != 200 {
+		t.Errorf("unconfigured tenant: Status = %d, want 200", byID["3"].Status)
+	}
+}
+
+func TestTenantConfig_RateLimitOverride(t *testing.T) {
+	orch := New(WithTenantConfigProvider(StaticTenantConfigs(map[string]TenantConfig{
+		"tenant-limited": {RateLimitRPS: 1, RateLimitBurst: 1},
+	})))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	limitedFirst := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-limited", Recipe: "echo"},
+	})
+	limitedSecond := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "2", TenantID: "tenant-limited", Recipe: "echo"},
+	})
+	unlimited := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "3", TenantID: "tenant-other", Recipe: "echo"},
+	})
+
+	if limitedFirst[0].Status != 200 {
+		t.Errorf("first request: Status = %d, want 200", limitedFirst[0].Status)
+	}
+	if limitedSecond[0].Status != 429 {
+		t.Errorf("second request: Status = %d, want 429", limitedSecond[0].Status)
+	}
+	if unlimited[0].Status != 200 {
+		t.Errorf("unconfigured tenant: Status = %d, want 200", unlimited[0].Status)
+	}
+}
+
+func TestTenantConfig_MaxConcurrency(t *testing.T) {
+	orch := New(WithTenantConfigProvider(StaticTenantConfigs(map[string]TenantConfig{
+		"tenant-capped": {MaxConcurrency: 1},
+	})))
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return "ok", nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-capped", Recipe: "slow"},
+		{ID: "2", TenantID: "tenant-capped", Recipe: "slow"},
+		{ID: "3", TenantID: "tenant-capped", Recipe: "slow"},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if peak > 1 {
+		t.Errorf("peak concurrency = %d, want 1", peak)
+	}
+}
+
+func TestWithTenantConfigProvider_NilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithTenantConfigProvider(nil) did not panic")
+		}
+	}()
+	New(WithTenantConfigProvider(nil))
+}
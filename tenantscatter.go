@@ -0,0 +1,59 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExpandToTenants expands one logical request into per-tenant copies,
+// one for each tenant in order. Each copy keeps the original recipe and
+// payload, takes the tenant as its TenantID, and gets a distinct ID of
+// the form "<id>:<tenant>" so the copies can share a batch. Panics if
+// no tenants are given.
+//
+// Example:
+//
+//	batch := relayer.ExpandToTenants(
+//		relayer.SubRequest{ID: "purge", Recipe: "purge-cache"},
+//		[]string{"acme", "globex"},
+//	)
+func ExpandToTenants(req SubRequest, tenants []string) []SubRequest {
+	if len(tenants) == 0 {
+		panic("tenant list cannot be empty")
+	}
+
+	batch := make([]SubRequest, len(tenants))
+	for i, tenant := range tenants {
+		sub := req
+		sub.ID = fmt.Sprintf("%s:%s", req.ID, tenant)
+		sub.TenantID = tenant
+		batch[i] = sub
+	}
+	return batch
+}
+
+// ExecuteForTenants runs one logical request against every tenant and
+// returns the responses grouped by tenant ID. The expanded requests run
+// as a single batch, so per-tenant rate limits and fairness apply as
+// usual. Useful for admin operations run "for all tenants".
+//
+// Example:
+//
+//	results := orch.ExecuteForTenants(ctx,
+//		relayer.SubRequest{ID: "purge", Recipe: "purge-cache"},
+//		allTenants)
+//	for tenant, resp := range results {
+//		if resp.Error != nil {
+//			log.Printf("tenant %s failed: %v", tenant, resp.Error)
+//		}
+//	}
+func (o *Orchestrator) ExecuteForTenants(ctx context.Context, req SubRequest, tenants []string) map[string]Response {
+	batch := ExpandToTenants(req, tenants)
+	responses := o.ExecuteBatch(ctx, batch)
+
+	grouped := make(map[string]Response, len(responses))
+	for i, resp := range responses {
+		grouped[batch[i].TenantID] = resp
+	}
+	return grouped
+}
@@ -0,0 +1,61 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestExpandToTenants(t *testing.T) {
+	batch := ExpandToTenants(
+		SubRequest{ID: "purge", Recipe: "purge-cache", Payload: "all"},
+		[]string{"acme", "globex"},
+	)
+
+	if len(batch) != 2 {
+		t.Fatalf("len = %d, want 2", len(batch))
+	}
+	if batch[0].ID != "purge:acme" || batch[0].TenantID != "acme" {
+		t.Errorf("batch[0] = %+v, want ID purge:acme for tenant acme", batch[0])
+	}
+	if batch[1].ID != "purge:globex" || batch[1].TenantID != "globex" {
+		t.Errorf("batch[1] = %+v, want ID purge:globex for tenant globex", batch[1])
+	}
+	if batch[0].Recipe != "purge-cache" || batch[0].Payload != "all" {
+		t.Errorf("batch[0] = %+v, want recipe and payload preserved", batch[0])
+	}
+}
+
+func TestExecuteForTenants_GroupsByTenant(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("purge-cache", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		info, _ := RequestInfoFromContext(ctx)
+		if info.TenantID == "globex" {
+			return nil, errors.New("backend unavailable")
+		}
+		return "purged " + info.TenantID, nil
+	})
+
+	results := orch.ExecuteForTenants(context.Background(),
+		SubRequest{ID: "purge", Recipe: "purge-cache"},
+		[]string{"acme", "globex"})
+
+	if len(results) != 2 {
+		t.Fatalf("len = %d, want one response per tenant", len(results))
+	}
+	if results["acme"].Status != 200 || results["acme"].Data != "purged acme" {
+		t.Errorf("acme = %+v, want (200, purged acme)", results["acme"])
+	}
+	if results["globex"].Status != 500 {
+		t.Errorf("globex Status = %d, want 500", results["globex"].Status)
+	}
+}
+
+func TestExpandToTenants_EmptyListPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for empty tenant list")
+		}
+	}()
+	ExpandToTenants(SubRequest{ID: "x", Recipe: "y"}, nil)
+}
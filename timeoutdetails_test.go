@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTimeoutDetails_RecipeTimeout(t *testing.T) {
+	orch := New(WithTimeout(time.Second))
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, &RecipeOption{Timeout: 20 * time.Millisecond})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeTimeout {
+		t.Fatalf("Error = %v, want %s", results[0].Error, ErrCodeTimeout)
+	}
+	details := results[0].Error.Details
+	if details["timeout_source"] != "recipe" {
+		t.Errorf("timeout_source = %v, want recipe", details["timeout_source"])
+	}
+	if details["timeout_ms"] != float64(20) {
+		t.Errorf("timeout_ms = %v, want 20", details["timeout_ms"])
+	}
+	elapsed, _ := details["elapsed_ms"].(float64)
+	if elapsed < 20 {
+		t.Errorf("elapsed_ms = %v, want >= 20", elapsed)
+	}
+	if _, ok := details["queue_wait_ms"]; !ok {
+		t.Error("queue_wait_ms missing from timeout details")
+	}
+}
+
+func TestTimeoutDetails_DefaultTimeout(t *testing.T) {
+	orch := New(WithTimeout(20 * time.Millisecond))
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	details := results[0].Error.Details
+	if details["timeout_source"] != "default" {
+		t.Errorf("timeout_source = %v, want default", details["timeout_source"])
+	}
+}
+
+func TestTimeoutDetails_ClientDeadline(t *testing.T) {
+	orch := New(WithTimeout(time.Second))
+	orch.RegisterRecipe("slow", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	results := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "slow"},
+	})
+
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeClientTimeout {
+		t.Fatalf("Error = %v, want %s", results[0].Error, ErrCodeClientTimeout)
+	}
+	if got := results[0].Error.Details["timeout_source"]; got != "client" {
+		t.Errorf("timeout_source = %v, want client", got)
+	}
+}
+
+func TestTimeoutDetails_AbsentOnSuccess(t *testing.T) {
+	orch := New(WithTimeout(time.Second))
+	orch.RegisterRecipe("fast", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "fast"},
+	})
+
+	if results[0].Error != nil {
+		t.Fatalf("Error = %v, want nil", results[0].Error)
+	}
+}
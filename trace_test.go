@@ -0,0 +1,102 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTraceID_Propagated(t *testing.T) {
+	orch := New()
+
+	var seenInRecipe string
+	orch.RegisterRecipe("traced", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		seenInRecipe, _ = TraceID(ctx)
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "traced", TraceID: "trace-abc"},
+	})
+
+	if seenInRecipe != "trace-abc" {
+		t.Errorf("TraceID in recipe = %q, want trace-abc", seenInRecipe)
+	}
+	if results[0].TraceID != "trace-abc" {
+		t.Errorf("Response.TraceID = %q, want trace-abc", results[0].TraceID)
+	}
+}
+
+func TestTraceID_GeneratedWhenMissing(t *testing.T) {
+	orch := New()
+
+	var seenInRecipe string
+	orch.RegisterRecipe("traced", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		seenInRecipe, _ = TraceID(ctx)
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "traced"},
+	})
+
+	if seenInRecipe == "" {
+		t.Error("TraceID should be generated when the request carries none")
+	}
+	if results[0].TraceID != seenInRecipe {
+		t.Errorf("Response.TraceID = %q, want %q (same as context)", results[0].TraceID, seenInRecipe)
+	}
+}
+
+func TestTraceID_HooksSeeTraceID(t *testing.T) {
+	var hookTrace string
+	hook := &funcHook{
+		onStart: func(ctx context.Context, req SubRequest) {
+			hookTrace = req.TraceID
+		},
+	}
+
+	orch := New(WithExecutionHook(hook))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok", TraceID: "trace-hook"},
+	})
+
+	if hookTrace != "trace-hook" {
+		t.Errorf("hook saw TraceID %q, want trace-hook", hookTrace)
+	}
+}
+
+func TestTraceID_UniquePerRequest(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+		{ID: "2", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].TraceID == results[1].TraceID {
+		t.Errorf("generated trace IDs collided: %q", results[0].TraceID)
+	}
+}
+
+// funcHook adapts functions to ExecutionHook for tests.
+type funcHook struct {
+	onStart func(ctx context.Context, req SubRequest)
+}
+
+func (h *funcHook) OnStart(ctx context.Context, req SubRequest) {
+	if h.onStart != nil {
+		h.onStart(ctx, req)
+	}
+}
+
+func (h *funcHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+}
@@ -2,6 +2,7 @@ package relayer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -10,22 +11,90 @@ import (
 // It contains all necessary information to identify and process a request
 // for a specific tenant using a named recipe.
 type SubRequest struct {
-	ID       string      `json:"id"`        // Unique request identifier
-	TenantID string      `json:"tenant_id"` // Tenant identifier for isolation
-	Recipe   string      `json:"recipe"`    // Name of the recipe to execute
-	Payload  interface{} `json:"payload"`   // Request payload (any JSON-serializable type)
+	ID       string      `json:"id"`                 // Unique request identifier
+	TenantID string      `json:"tenant_id"`          // Tenant identifier for isolation
+	Recipe   string      `json:"recipe"`             // Name of the recipe to execute
+	Payload  interface{} `json:"payload"`            // Request payload (any JSON-serializable type)
+	Priority int         `json:"priority,omitempty"` // Scheduling priority; higher values survive load shedding
+
+	// SerializationKey forces ordering within a batch: requests sharing a
+	// non-empty key execute sequentially in input order (e.g., operations
+	// on the same account), while requests with different or empty keys
+	// still run concurrently. Enables safe read-modify-write recipes.
+	SerializationKey string `json:"serialization_key,omitempty"`
+
+	// TraceID correlates this request across logs, hooks, and downstream
+	// calls. Generated by the orchestrator when empty; propagate an
+	// upstream trace ID by setting it explicitly.
+	TraceID string `json:"trace_id,omitempty"`
+
+	// DependsOn lists request IDs in the same batch that must complete
+	// before this request runs. Honored by ExecuteBatchDAG, which also
+	// resolves ${id.data.field} placeholders in the payload against
+	// upstream results. Ignored by the other execution methods.
+	DependsOn []string `json:"depends_on,omitempty"`
 }
 
 // Response represents the result of processing a SubRequest.
 // It includes the request ID, status code, data, error information,
 // execution duration, and tenant ID.
 type Response struct {
-	ID       string        `json:"id"`                 // Request ID matching SubRequest.ID
-	Status   int           `json:"status"`             // HTTP-style status code (200, 404, 500, etc.)
-	Data     interface{}   `json:"data,omitempty"`     // Response data from successful execution
-	Error    *Error        `json:"error,omitempty"`    // Error details if execution failed
-	Duration time.Duration `json:"duration_ms"`        // Execution duration in milliseconds
-	TenantID string        `json:"tenant_id,omitempty"` // Tenant ID for filtering
+	ID       string        `json:"id"`              // Request ID matching SubRequest.ID
+	Status   int           `json:"status"`          // HTTP-style status code (200, 404, 500, etc.)
+	Data     interface{}   `json:"data,omitempty"`  // Response data from successful execution
+	Error    *Error        `json:"error,omitempty"` // Error details if execution failed
+	Duration time.Duration `json:"duration_ms"`     // Execution duration in milliseconds
+	// QueueDuration is time spent waiting for a concurrency slot before
+	// execution began, kept separate from Duration so latency analysis
+	// doesn't conflate queuing with handler time. Zero when no
+	// concurrency limiting is configured.
+	QueueDuration time.Duration `json:"queue_duration_ms,omitempty"`
+	TenantID      string        `json:"tenant_id,omitempty"` // Tenant ID for filtering
+	TraceID       string        `json:"trace_id,omitempty"`  // Trace ID matching SubRequest.TraceID
+	BatchID       string        `json:"batch_id,omitempty"`  // ID shared by all responses of one batch
+
+	// Execution start/completion times, populated only when
+	// WithResponseTimestamps is enabled. Marshalled as RFC 3339.
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// Meta carries execution annotations recorded while the request ran,
+	// such as which branch a composite recipe's conditional took. For
+	// failed requests annotations are merged into Error.Details instead.
+	Meta map[string]interface{} `json:"meta,omitempty"`
+}
+
+// MarshalJSON emits Duration in milliseconds (fractional, so sub-ms
+// durations survive), matching the duration_ms field name. Without this,
+// time.Duration would marshal as raw nanoseconds.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type alias Response
+	return json.Marshal(struct {
+		alias
+		Duration      float64 `json:"duration_ms"`
+		QueueDuration float64 `json:"queue_duration_ms,omitempty"`
+	}{
+		alias:         alias(r),
+		Duration:      float64(r.Duration) / float64(time.Millisecond),
+		QueueDuration: float64(r.QueueDuration) / float64(time.Millisecond),
+	})
+}
+
+// UnmarshalJSON parses duration_ms back from milliseconds.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type alias Response
+	aux := struct {
+		*alias
+		Duration      float64 `json:"duration_ms"`
+		QueueDuration float64 `json:"queue_duration_ms"`
+	}{alias: (*alias)(r)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Duration = time.Duration(aux.Duration * float64(time.Millisecond))
+	r.QueueDuration = time.Duration(aux.QueueDuration * float64(time.Millisecond))
+	return nil
 }
 
 // Error provides structured error information with code, message, and optional details.
@@ -42,13 +111,24 @@ func (e *Error) Error() string {
 
 // Common error codes used throughout the library
 const (
-	ErrCodeRecipeNotFound  = "RECIPE_NOT_FOUND"  // Recipe name not registered
-	ErrCodeTimeout         = "TIMEOUT"           // Recipe execution timeout
-	ErrCodePanic           = "PANIC"             // Recipe panicked during execution
-	ErrCodeRecipeExecution = "RECIPE_EXECUTION"  // Recipe returned error
-	ErrCodeInvalidPayload  = "INVALID_PAYLOAD"   // Payload validation failed
-	ErrCodeBatchTooLarge   = "BATCH_TOO_LARGE"   // Batch size exceeds limit
-	ErrCodeInvalidRequest  = "INVALID_REQUEST"   // Request validation failed
+	ErrCodeRecipeNotFound  = "RECIPE_NOT_FOUND" // Recipe name not registered
+	ErrCodeTimeout         = "TIMEOUT"          // Recipe execution timeout
+	ErrCodePanic           = "PANIC"            // Recipe panicked during execution
+	ErrCodeRecipeExecution = "RECIPE_EXECUTION" // Recipe returned error
+	ErrCodeInvalidPayload  = "INVALID_PAYLOAD"  // Payload validation failed
+	ErrCodeBatchTooLarge   = "BATCH_TOO_LARGE"  // Batch size exceeds limit
+	ErrCodeInvalidRequest  = "INVALID_REQUEST"  // Request validation failed
+	ErrCodeBudgetExceeded  = "BUDGET_EXCEEDED"  // Request exceeded its resource budget
+	ErrCodeOverloaded      = "OVERLOADED"       // Orchestrator is shedding load
+	ErrCodeRateLimited     = "RATE_LIMITED"     // Tenant exceeded its rate limit
+	ErrCodeShuttingDown    = "SHUTTING_DOWN"    // Orchestrator is shutting down
+	ErrCodeSkipped         = "SKIPPED"          // Request skipped after earlier failure
+
+	ErrCodeDependencyFailed = "DEPENDENCY_FAILED" // Upstream dependency did not succeed
+	ErrCodeClientTimeout    = "CLIENT_TIMEOUT"    // Caller's context deadline was the binding constraint
+	ErrCodeUnauthorized     = "UNAUTHORIZED"      // Caller is not authenticated
+	ErrCodeForbidden        = "FORBIDDEN"         // Caller is not entitled to the recipe
+	ErrCodeQuotaExceeded    = "QUOTA_EXCEEDED"    // Tenant exhausted its request quota
 )
 
 // Handler is the function signature for recipe implementations.
@@ -113,3 +193,104 @@ func FilterByTenant(responses []Response, tenantID string) []Response {
 	}
 	return filtered
 }
+
+// FilterFailed returns only failed responses (non-2xx status codes).
+// The counterpart of FilterSuccess.
+//
+// Example:
+//
+//	failures := relayer.FilterFailed(responses)
+func FilterFailed(responses []Response) []Response {
+	failed := make([]Response, 0, len(responses))
+	for _, resp := range responses {
+		if resp.Status < 200 || resp.Status >= 300 {
+			failed = append(failed, resp)
+		}
+	}
+	return failed
+}
+
+// GroupByTenant groups responses by tenant ID.
+//
+// Example:
+//
+//	for tenant, results := range relayer.GroupByTenant(responses) {
+//		log.Printf("%s: %d results", tenant, len(results))
+//	}
+func GroupByTenant(responses []Response) map[string][]Response {
+	grouped := make(map[string][]Response)
+	for _, resp := range responses {
+		grouped[resp.TenantID] = append(grouped[resp.TenantID], resp)
+	}
+	return grouped
+}
+
+// GroupByStatus groups responses by status code.
+//
+// Example:
+//
+//	byStatus := relayer.GroupByStatus(responses)
+//	log.Printf("%d timeouts", len(byStatus[504]))
+func GroupByStatus(responses []Response) map[int][]Response {
+	grouped := make(map[int][]Response)
+	for _, resp := range responses {
+		grouped[resp.Status] = append(grouped[resp.Status], resp)
+	}
+	return grouped
+}
+
+// Partition splits responses into those matching the predicate and those
+// that don't.
+//
+// Example:
+//
+//	slow, fast := relayer.Partition(responses, func(r relayer.Response) bool {
+//		return r.Duration > time.Second
+//	})
+func Partition(responses []Response, pred func(Response) bool) (matching, rest []Response) {
+	for _, resp := range responses {
+		if pred(resp) {
+			matching = append(matching, resp)
+		} else {
+			rest = append(rest, resp)
+		}
+	}
+	return matching, rest
+}
+
+// MapData extracts typed data from successful responses, skipping
+// failures and responses whose data is not a T.
+//
+// Example:
+//
+//	users := relayer.MapData[User](responses)
+func MapData[T any](responses []Response) []T {
+	mapped := make([]T, 0, len(responses))
+	for _, resp := range responses {
+		if resp.Status < 200 || resp.Status >= 300 {
+			continue
+		}
+		if data, ok := resp.Data.(T); ok {
+			mapped = append(mapped, data)
+		}
+	}
+	return mapped
+}
+
+// FirstError returns the error of the first failed response, or nil if
+// every response succeeded. Useful for fail-fast callers that only need
+// to report one failure.
+//
+// Example:
+//
+//	if err := relayer.FirstError(responses); err != nil {
+//		return err
+//	}
+func FirstError(responses []Response) *Error {
+	for _, resp := range responses {
+		if resp.Error != nil {
+			return resp.Error
+		}
+	}
+	return nil
+}
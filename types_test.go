@@ -1,6 +1,9 @@
 package relayer
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
@@ -156,3 +159,198 @@ func TestError_WithDetails(t *testing.T) {
 		t.Errorf("Error.Details['field'] = %v, want %v", err.Details["field"], "email")
 	}
 }
+
+func TestFilterFailed(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 200},
+		{ID: "2", Status: 404},
+		{ID: "3", Status: 500},
+	}
+
+	failed := FilterFailed(responses)
+
+	if len(failed) != 2 {
+		t.Fatalf("FilterFailed() = %d responses, want 2", len(failed))
+	}
+	if failed[0].ID != "2" || failed[1].ID != "3" {
+		t.Errorf("FilterFailed() IDs = [%s, %s], want [2, 3]", failed[0].ID, failed[1].ID)
+	}
+}
+
+func TestGroupByTenant(t *testing.T) {
+	responses := []Response{
+		{ID: "1", TenantID: "a"},
+		{ID: "2", TenantID: "b"},
+		{ID: "3", TenantID: "a"},
+	}
+
+	grouped := GroupByTenant(responses)
+
+	if len(grouped["a"]) != 2 || len(grouped["b"]) != 1 {
+		t.Errorf("GroupByTenant() = a:%d b:%d, want a:2 b:1", len(grouped["a"]), len(grouped["b"]))
+	}
+}
+
+func TestGroupByStatus(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 200},
+		{ID: "2", Status: 504},
+		{ID: "3", Status: 200},
+	}
+
+	grouped := GroupByStatus(responses)
+
+	if len(grouped[200]) != 2 || len(grouped[504]) != 1 {
+		t.Errorf("GroupByStatus() = 200:%d 504:%d, want 200:2 504:1", len(grouped[200]), len(grouped[504]))
+	}
+}
+
+func TestPartition(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 200},
+		{ID: "2", Status: 500},
+		{ID: "3", Status: 200},
+	}
+
+	ok, failed := Partition(responses, func(r Response) bool { return r.Status == 200 })
+
+	if len(ok) != 2 || len(failed) != 1 {
+		t.Errorf("Partition() = %d/%d, want 2/1", len(ok), len(failed))
+	}
+	if failed[0].ID != "2" {
+		t.Errorf("non-matching partition ID = %s, want 2", failed[0].ID)
+	}
+}
+
+func TestMapData(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 200, Data: "alpha"},
+		{ID: "2", Status: 500, Data: "skipped-failure"},
+		{ID: "3", Status: 200, Data: 42}, // wrong type, skipped
+		{ID: "4", Status: 200, Data: "beta"},
+	}
+
+	strings := MapData[string](responses)
+
+	if len(strings) != 2 || strings[0] != "alpha" || strings[1] != "beta" {
+		t.Errorf("MapData[string]() = %v, want [alpha beta]", strings)
+	}
+}
+
+func TestFirstError(t *testing.T) {
+	responses := []Response{
+		{ID: "1", Status: 200},
+		{ID: "2", Status: 500, Error: &Error{Code: ErrCodeRecipeExecution, Message: "boom"}},
+		{ID: "3", Status: 504, Error: &Error{Code: ErrCodeTimeout, Message: "late"}},
+	}
+
+	err := FirstError(responses)
+	if err == nil || err.Code != ErrCodeRecipeExecution {
+		t.Errorf("FirstError() = %+v, want first failure's error", err)
+	}
+
+	if got := FirstError(responses[:1]); got != nil {
+		t.Errorf("FirstError() on all-success = %+v, want nil", got)
+	}
+}
+
+func TestResponse_MarshalJSON_DurationMilliseconds(t *testing.T) {
+	resp := Response{
+		ID:       "1",
+		Status:   200,
+		Duration: 1500 * time.Microsecond,
+	}
+
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	ms, ok := decoded["duration_ms"].(float64)
+	if !ok {
+		t.Fatalf("duration_ms = %v (%T), want number", decoded["duration_ms"], decoded["duration_ms"])
+	}
+	if ms != 1.5 {
+		t.Errorf("duration_ms = %v, want 1.5", ms)
+	}
+}
+
+func TestResponse_JSONRoundTrip(t *testing.T) {
+	original := Response{
+		ID:       "1",
+		Status:   504,
+		Error:    &Error{Code: ErrCodeTimeout, Message: "late"},
+		Duration: 250 * time.Millisecond,
+		TenantID: "t",
+	}
+
+	raw, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var decoded Response
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if decoded.Duration != original.Duration {
+		t.Errorf("Duration = %v, want %v", decoded.Duration, original.Duration)
+	}
+	if decoded.Status != 504 || decoded.Error == nil || decoded.Error.Code != ErrCodeTimeout {
+		t.Errorf("decoded = %+v, want original fields preserved", decoded)
+	}
+}
+
+func TestWithResponseTimestamps(t *testing.T) {
+	orch := New(WithResponseTimestamps())
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	before := time.Now()
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	resp := results[0]
+	if resp.StartedAt == nil || resp.CompletedAt == nil {
+		t.Fatal("StartedAt/CompletedAt should be populated when enabled")
+	}
+	if resp.StartedAt.Before(before.Add(-time.Second)) {
+		t.Errorf("StartedAt = %v, want around now", resp.StartedAt)
+	}
+	if resp.CompletedAt.Before(*resp.StartedAt) {
+		t.Error("CompletedAt should not precede StartedAt")
+	}
+
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if !strings.Contains(string(raw), "started_at") {
+		t.Errorf("marshalled response %s missing started_at", raw)
+	}
+}
+
+func TestResponse_TimestampsOmittedByDefault(t *testing.T) {
+	orch := New()
+
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	if results[0].StartedAt != nil || results[0].CompletedAt != nil {
+		t.Error("timestamps should be nil unless WithResponseTimestamps is set")
+	}
+}
@@ -0,0 +1,209 @@
+package relayer
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// TenantUsage aggregates one tenant's activity: request count, outcome
+// split, and cumulative execution duration. Durations exclude queue
+// wait, so billing reflects handler time actually consumed.
+type TenantUsage struct {
+	TenantID      string        `json:"tenant_id"`
+	Requests      int64         `json:"requests"`
+	Successes     int64         `json:"successes"`
+	Failures      int64         `json:"failures"`
+	TotalDuration time.Duration `json:"total_duration_ms"`
+}
+
+// UsageHook receives periodic usage flushes, one entry per tenant with
+// activity since the previous flush. Tenants with no activity are
+// omitted. Called from the flush goroutine; implementations that export
+// to a billing system should buffer and return quickly.
+type UsageHook interface {
+	OnUsage(usage []TenantUsage)
+}
+
+// UsageHookFunc adapts a plain function to the UsageHook interface.
+type UsageHookFunc func(usage []TenantUsage)
+
+// OnUsage implements UsageHook.
+func (f UsageHookFunc) OnUsage(usage []TenantUsage) { f(usage) }
+
+// usageRetention bounds how far back Usage can query. Older buckets are
+// pruned as new activity arrives.
+const usageRetention = time.Hour
+
+// usageBucket accumulates one tenant's activity for one minute.
+type usageBucket struct {
+	requests  int64
+	successes int64
+	failures  int64
+	duration  time.Duration
+}
+
+// usageAccountant tracks per-tenant activity in one-minute buckets for
+// windowed queries, plus a pending set for periodic hook flushes.
+type usageAccountant struct {
+	mu      sync.Mutex
+	buckets map[string]map[int64]*usageBucket // tenant -> unix minute -> bucket
+	pending map[string]*TenantUsage           // accumulated since the last flush
+}
+
+func newUsageAccountant() *usageAccountant {
+	return &usageAccountant{
+		buckets: make(map[string]map[int64]*usageBucket),
+		pending: make(map[string]*TenantUsage),
+	}
+}
+
+func (u *usageAccountant) record(tenantID string, duration time.Duration, failed bool, now time.Time) {
+	minute := now.Unix() / 60
+	oldest := minute - int64(usageRetention/time.Minute)
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	tenant := u.buckets[tenantID]
+	if tenant == nil {
+		tenant = make(map[int64]*usageBucket)
+		u.buckets[tenantID] = tenant
+	}
+	b := tenant[minute]
+	if b == nil {
+		b = &usageBucket{}
+		tenant[minute] = b
+		// Prune buckets past retention while we hold the lock
+		for m := range tenant {
+			if m < oldest {
+				delete(tenant, m)
+			}
+		}
+	}
+	b.requests++
+	b.duration += duration
+
+	p := u.pending[tenantID]
+	if p == nil {
+		p = &TenantUsage{TenantID: tenantID}
+		u.pending[tenantID] = p
+	}
+	p.Requests++
+	p.TotalDuration += duration
+	if failed {
+		b.failures++
+		p.Failures++
+	} else {
+		b.successes++
+		p.Successes++
+	}
+}
+
+// usage sums the tenant's buckets within the window ending now.
+func (u *usageAccountant) usage(tenantID string, window time.Duration, now time.Time) TenantUsage {
+	from := now.Add(-window).Unix() / 60
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	total := TenantUsage{TenantID: tenantID}
+	for minute, b := range u.buckets[tenantID] {
+		if minute < from {
+			continue
+		}
+		total.Requests += b.requests
+		total.Successes += b.successes
+		total.Failures += b.failures
+		total.TotalDuration += b.duration
+	}
+	return total
+}
+
+// flushPending returns and clears the usage accumulated since the last
+// flush, sorted by tenant ID for stable output.
+func (u *usageAccountant) flushPending() []TenantUsage {
+	u.mu.Lock()
+	flushed := make([]TenantUsage, 0, len(u.pending))
+	for _, usage := range u.pending {
+		flushed = append(flushed, *usage)
+	}
+	u.pending = make(map[string]*TenantUsage)
+	u.mu.Unlock()
+
+	sort.Slice(flushed, func(i, j int) bool { return flushed[i].TenantID < flushed[j].TenantID })
+	return flushed
+}
+
+// WithUsageAccounting tracks per-tenant request counts, outcomes, and
+// cumulative execution duration, queryable via Usage. Retains up to an
+// hour of history at one-minute granularity.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithUsageAccounting())
+func WithUsageAccounting() Option {
+	return func(o *Orchestrator) {
+		o.usage = newUsageAccountant()
+	}
+}
+
+// WithUsageHook enables usage accounting and flushes the accumulated
+// per-tenant usage to hook every interval, for billing pipelines that
+// meter by batch usage. Each flush carries only the activity since the
+// previous one. The flusher stops (after a final flush) when the
+// orchestrator shuts down. Panics if hook is nil or interval is not
+// positive.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithUsageHook(billingExporter, time.Minute))
+func WithUsageHook(hook UsageHook, interval time.Duration) Option {
+	return func(o *Orchestrator) {
+		if hook == nil {
+			panic("usage hook cannot be nil")
+		}
+		if interval <= 0 {
+			panic("usage flush interval must be positive")
+		}
+		o.usage = newUsageAccountant()
+		o.usageHook = hook
+		o.usageFlushEvery = interval
+	}
+}
+
+// Usage returns the tenant's aggregate usage over the trailing window
+// (capped at one hour of retained history). Returns zeroes when usage
+// accounting is not enabled.
+//
+// Example:
+//
+//	u := orch.Usage("tenant-42", 15*time.Minute)
+//	fmt.Printf("%d requests, %v handler time\n", u.Requests, u.TotalDuration)
+func (o *Orchestrator) Usage(tenantID string, window time.Duration) TenantUsage {
+	if o.usage == nil {
+		return TenantUsage{TenantID: tenantID}
+	}
+	return o.usage.usage(tenantID, window, o.clock.Now())
+}
+
+// flushUsage periodically delivers pending usage to the hook until
+// shutdown, then delivers whatever remains.
+func (o *Orchestrator) flushUsage() {
+	ticker := time.NewTicker(o.usageFlushEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if flushed := o.usage.flushPending(); len(flushed) > 0 {
+				o.usageHook.OnUsage(flushed)
+			}
+		case <-o.shutdownCtx.Done():
+			if flushed := o.usage.flushPending(); len(flushed) > 0 {
+				o.usageHook.OnUsage(flushed)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestUsage_AggregatesPerTenant(t *testing.T) {
+	orch := New(WithUsageAccounting())
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	orch.RegisterRecipe("fail", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "ok"},
+		{ID: "2", TenantID: "tenant-a", Recipe: "fail"},
+		{ID: "3", TenantID: "tenant-b", Recipe: "ok"},
+	})
+
+	usage := orch.Usage("tenant-a", time.Minute)
+	if usage.Requests != 2 {
+		t.Errorf("Requests = %d, want 2", usage.Requests)
+	}
+	if usage.Successes != 1 || usage.Failures != 1 {
+		t.Errorf("Successes/Failures = %d/%d, want 1/1", usage.Successes, usage.Failures)
+	}
+
+	other := orch.Usage("tenant-b", time.Minute)
+	if other.Requests != 1 || other.Failures != 0 {
+		t.Errorf("tenant-b usage = %+v, want 1 successful request", other)
+	}
+}
+
+func TestUsage_DisabledReturnsZeroes(t *testing.T) {
+	orch := New()
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "ok"},
+	})
+
+	usage := orch.Usage("t", time.Minute)
+	if usage.Requests != 0 {
+		t.Errorf("Requests = %d, want 0 without accounting", usage.Requests)
+	}
+	if usage.TenantID != "t" {
+		t.Errorf("TenantID = %q, want t", usage.TenantID)
+	}
+}
+
+func TestUsage_WindowExcludesOldBuckets(t *testing.T) {
+	u := newUsageAccountant()
+	now := time.Now()
+	u.record("t", 10*time.Millisecond, false, now.Add(-10*time.Minute))
+	u.record("t", 10*time.Millisecond, false, now)
+
+	recent := u.usage("t", 2*time.Minute, now)
+	if recent.Requests != 1 {
+		t.Errorf("recent Requests = %d, want 1", recent.Requests)
+	}
+	all := u.usage("t", 30*time.Minute, now)
+	if all.Requests != 2 {
+		t.Errorf("all Requests = %d, want 2", all.Requests)
+	}
+}
+
+func TestWithUsageHook_FlushesPeriodically(t *testing.T) {
+	var mu sync.Mutex
+	var flushes [][]TenantUsage
+	orch := New(WithUsageHook(UsageHookFunc(func(usage []TenantUsage) {
+		mu.Lock()
+		flushes = append(flushes, usage)
+		mu.Unlock()
+	}), 20*time.Millisecond))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "ok"},
+		{ID: "2", TenantID: "tenant-a", Recipe: "ok"},
+	})
+
+	deadline := time.After(2 * time.Second)
+	for {
+		mu.Lock()
+		n := len(flushes)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("no usage flush within 2s")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(flushes[0]) != 1 || flushes[0][0].TenantID != "tenant-a" {
+		t.Fatalf("flush = %+v, want one tenant-a entry", flushes[0])
+	}
+	if flushes[0][0].Requests != 2 {
+		t.Errorf("flushed Requests = %d, want 2", flushes[0][0].Requests)
+	}
+}
+
+func TestWithUsageHook_InvalidArgsPanic(t *testing.T) {
+	for name, opt := range map[string]func() Option{
+		"nil hook":      func() Option { return WithUsageHook(nil, time.Second) },
+		"zero interval": func() Option { return WithUsageHook(UsageHookFunc(func([]TenantUsage) {}), 0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: WithUsageHook did not panic", name)
+				}
+			}()
+			New(opt())
+		}()
+	}
+}
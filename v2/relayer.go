@@ -0,0 +1,208 @@
+// Package relayerv2 provides a revised orchestrator API with a consistent
+// error-returning surface. It wraps the v1 package rather than forking it:
+// execution semantics (tenant isolation, panic recovery, hooks, timeouts)
+// are identical, but the API differs in four deliberate ways:
+//
+//   - Constructors and registration return errors instead of panicking.
+//   - ExecuteBatch distinguishes infrastructure errors (nil context,
+//     missing deadline, oversized batch) from per-item results.
+//   - A context deadline is a mandatory input: batch execution without an
+//     upper bound has caused production incidents often enough that v2
+//     refuses it outright.
+//   - Hook options are variadic; multiple hooks fan out in order.
+//
+// v1 remains fully supported; v2 exists for new code that wants the
+// stricter contract without breaking existing users.
+package relayerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	relayer "github.com/voseghale/batching"
+)
+
+// Re-exported v1 types. The wire format and semantics are unchanged in v2.
+type (
+	SubRequest    = relayer.SubRequest
+	Response      = relayer.Response
+	Error         = relayer.Error
+	Handler       = relayer.Handler
+	RecipeOption  = relayer.RecipeOption
+	ExecutionHook = relayer.ExecutionHook
+	PanicHook     = relayer.PanicHook
+)
+
+// Infrastructure errors returned by ExecuteBatch. These indicate the batch
+// was never executed, as opposed to per-item failures reported in Responses.
+var (
+	ErrNilContext      = errors.New("relayerv2: nil context")
+	ErrMissingDeadline = errors.New("relayerv2: context must carry a deadline")
+	ErrBatchTooLarge   = errors.New("relayerv2: batch exceeds configured size limit")
+)
+
+// Orchestrator is the v2 façade over the v1 orchestrator.
+type Orchestrator struct {
+	inner        *relayer.Orchestrator
+	maxBatchSize int
+}
+
+// config accumulates v2 options before the v1 orchestrator is built.
+type config struct {
+	v1Opts       []relayer.Option
+	maxBatchSize int
+}
+
+// Option configures a v2 Orchestrator. Unlike v1 options, invalid values
+// surface as errors from New instead of panics.
+type Option func(*config) error
+
+// WithTimeout sets the default recipe timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) error {
+		if timeout <= 0 {
+			return fmt.Errorf("relayerv2: timeout must be positive, got %v", timeout)
+		}
+		c.v1Opts = append(c.v1Opts, relayer.WithTimeout(timeout))
+		return nil
+	}
+}
+
+// WithMaxConcurrency limits concurrent recipe executions (0 = unlimited).
+func WithMaxConcurrency(max int) Option {
+	return func(c *config) error {
+		if max < 0 {
+			return fmt.Errorf("relayerv2: max concurrency must be non-negative, got %d", max)
+		}
+		c.v1Opts = append(c.v1Opts, relayer.WithMaxConcurrency(max))
+		return nil
+	}
+}
+
+// WithMaxBatchSize limits batch size (0 = unlimited). Oversized batches
+// are rejected with ErrBatchTooLarge rather than per-item 413 responses.
+func WithMaxBatchSize(max int) Option {
+	return func(c *config) error {
+		if max < 0 {
+			return fmt.Errorf("relayerv2: max batch size must be non-negative, got %d", max)
+		}
+		c.maxBatchSize = max
+		return nil
+	}
+}
+
+// WithExecutionHooks registers one or more execution hooks, invoked in
+// registration order.
+func WithExecutionHooks(hooks ...ExecutionHook) Option {
+	return func(c *config) error {
+		kept := make([]ExecutionHook, 0, len(hooks))
+		for _, h := range hooks {
+			if h != nil {
+				kept = append(kept, h)
+			}
+		}
+		if len(kept) == 0 {
+			return nil
+		}
+		c.v1Opts = append(c.v1Opts, relayer.WithExecutionHook(&fanOutHook{hooks: kept}))
+		return nil
+	}
+}
+
+// WithPanicHooks registers one or more panic hooks, invoked in
+// registration order.
+func WithPanicHooks(hooks ...PanicHook) Option {
+	return func(c *config) error {
+		kept := make([]PanicHook, 0, len(hooks))
+		for _, h := range hooks {
+			if h != nil {
+				kept = append(kept, h)
+			}
+		}
+		if len(kept) == 0 {
+			return nil
+		}
+		c.v1Opts = append(c.v1Opts, relayer.WithPanicHook(&fanOutHook{panicHooks: kept}))
+		return nil
+	}
+}
+
+// fanOutHook dispatches lifecycle events to multiple hooks in order.
+type fanOutHook struct {
+	hooks      []ExecutionHook
+	panicHooks []PanicHook
+}
+
+func (f *fanOutHook) OnStart(ctx context.Context, req SubRequest) {
+	for _, h := range f.hooks {
+		h.OnStart(ctx, req)
+	}
+}
+
+func (f *fanOutHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	for _, h := range f.hooks {
+		h.OnComplete(ctx, req, resp, duration)
+	}
+}
+
+func (f *fanOutHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	for _, h := range f.panicHooks {
+		h.OnPanic(ctx, req, recovered)
+	}
+}
+
+// New creates a v2 Orchestrator. Invalid options return an error instead
+// of panicking.
+func New(opts ...Option) (*Orchestrator, error) {
+	c := &config{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return &Orchestrator{
+		inner:        relayer.New(c.v1Opts...),
+		maxBatchSize: c.maxBatchSize,
+	}, nil
+}
+
+// RegisterRecipe registers a handler for a recipe name. Unlike v1, it
+// returns an error for empty names, nil handlers, and duplicate
+// registrations instead of panicking or silently overwriting.
+func (o *Orchestrator) RegisterRecipe(name string, handler Handler, opts ...*RecipeOption) error {
+	if name == "" {
+		return errors.New("relayerv2: recipe name cannot be empty")
+	}
+	if handler == nil {
+		return errors.New("relayerv2: recipe handler cannot be nil")
+	}
+	return o.inner.RegisterRecipeStrict(name, handler, opts...)
+}
+
+// ExecuteBatch processes a batch of requests concurrently. Infrastructure
+// problems — nil context, missing deadline, oversized batch — return an
+// error and no responses; per-request failures are reported in the
+// returned responses exactly as in v1.
+func (o *Orchestrator) ExecuteBatch(ctx context.Context, batch []SubRequest) ([]Response, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, ErrMissingDeadline
+	}
+	if o.maxBatchSize > 0 && len(batch) > o.maxBatchSize {
+		return nil, fmt.Errorf("%w: %d > %d", ErrBatchTooLarge, len(batch), o.maxBatchSize)
+	}
+	return o.inner.ExecuteBatch(ctx, batch), nil
+}
+
+// Unwrap returns the underlying v1 orchestrator for callers that need
+// v1-only features during migration.
+func (o *Orchestrator) Unwrap() *relayer.Orchestrator {
+	return o.inner
+}
@@ -0,0 +1,144 @@
+package relayerv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNew_InvalidOptionReturnsError(t *testing.T) {
+	_, err := New(WithTimeout(-1))
+	if err == nil {
+		t.Fatal("Expected error for negative timeout")
+	}
+
+	_, err = New(WithMaxConcurrency(-1))
+	if err == nil {
+		t.Fatal("Expected error for negative max concurrency")
+	}
+}
+
+func TestRegisterRecipe_Errors(t *testing.T) {
+	orch, err := New()
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	handler := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	}
+
+	if err := orch.RegisterRecipe("", handler); err == nil {
+		t.Error("Expected error for empty name")
+	}
+
+	if err := orch.RegisterRecipe("echo", nil); err == nil {
+		t.Error("Expected error for nil handler")
+	}
+
+	if err := orch.RegisterRecipe("echo", handler); err != nil {
+		t.Errorf("First registration failed: %v", err)
+	}
+
+	if err := orch.RegisterRecipe("echo", handler); err == nil {
+		t.Error("Expected error for duplicate registration")
+	}
+}
+
+func TestExecuteBatch_RequiresDeadline(t *testing.T) {
+	orch, _ := New()
+
+	_, err := orch.ExecuteBatch(context.Background(), nil)
+	if !errors.Is(err, ErrMissingDeadline) {
+		t.Errorf("err = %v, want ErrMissingDeadline", err)
+	}
+}
+
+func TestExecuteBatch_BatchTooLargeIsError(t *testing.T) {
+	orch, _ := New(WithMaxBatchSize(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "r"},
+		{ID: "2", TenantID: "t", Recipe: "r"},
+	})
+
+	if !errors.Is(err, ErrBatchTooLarge) {
+		t.Errorf("err = %v, want ErrBatchTooLarge", err)
+	}
+}
+
+func TestExecuteBatch_Success(t *testing.T) {
+	orch, _ := New(WithTimeout(time.Second))
+
+	err := orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterRecipe failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	results, err := orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hello"},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch returned error: %v", err)
+	}
+
+	if len(results) != 1 || results[0].Status != 200 || results[0].Data != "hello" {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+}
+
+func TestWithExecutionHooks_FanOut(t *testing.T) {
+	var calls []string
+
+	mkHook := func(name string) ExecutionHook {
+		return &recordingHook{name: name, calls: &calls}
+	}
+
+	orch, err := New(WithExecutionHooks(mkHook("a"), mkHook("b")))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	orch.ExecuteBatch(ctx, []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	want := []string{"a:start", "b:start", "a:complete", "b:complete"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("calls[%d] = %s, want %s", i, calls[i], want[i])
+		}
+	}
+}
+
+type recordingHook struct {
+	name  string
+	calls *[]string
+}
+
+func (h *recordingHook) OnStart(ctx context.Context, req SubRequest) {
+	*h.calls = append(*h.calls, h.name+":start")
+}
+
+func (h *recordingHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	*h.calls = append(*h.calls, h.name+":complete")
+}
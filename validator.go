@@ -0,0 +1,34 @@
+package relayer
+
+// RequestValidator checks a request before execution, after the
+// built-in non-empty ID/TenantID/Recipe checks have passed. Return nil
+// to admit the request, or an *Error to reject it with a 400 response
+// carrying that error. Use it to enforce deployment-specific invariants
+// such as ID formats, tenant ID patterns, or payload shapes.
+//
+// Validators run on every request and must be safe for concurrent use.
+type RequestValidator func(req SubRequest) *Error
+
+// WithRequestValidator sets the custom validator run before each
+// request executes. Rejected requests never reach the rate limiter,
+// cache, or handler. Panics if validate is nil.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithRequestValidator(func(req relayer.SubRequest) *relayer.Error {
+//		if !tenantIDPattern.MatchString(req.TenantID) {
+//			return &relayer.Error{
+//				Code:    relayer.ErrCodeInvalidRequest,
+//				Message: "tenant ID must match " + tenantIDPattern.String(),
+//			}
+//		}
+//		return nil
+//	}))
+func WithRequestValidator(validate RequestValidator) Option {
+	return func(o *Orchestrator) {
+		if validate == nil {
+			panic("request validator cannot be nil")
+		}
+		o.validator = validate
+	}
+}
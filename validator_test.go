@@ -0,0 +1,90 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWithRequestValidator_RejectsWith400(t *testing.T) {
+	orch := New(WithRequestValidator(func(req SubRequest) *Error {
+		if !strings.HasPrefix(req.ID, "req-") {
+			return &Error{
+				Code:    ErrCodeInvalidRequest,
+				Message: "request ID must start with req-",
+			}
+		}
+		return nil
+	}))
+
+	var executed bool
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		executed = true
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "bad-id", TenantID: "t", Recipe: "echo"},
+	})
+
+	if results[0].Status != 400 {
+		t.Errorf("Status = %d, want 400", results[0].Status)
+	}
+	if results[0].Error == nil || results[0].Error.Code != ErrCodeInvalidRequest {
+		t.Errorf("Error = %v, want code %s", results[0].Error, ErrCodeInvalidRequest)
+	}
+	if executed {
+		t.Error("handler executed despite validation failure")
+	}
+}
+
+func TestWithRequestValidator_AdmitsValidRequests(t *testing.T) {
+	orch := New(WithRequestValidator(func(req SubRequest) *Error {
+		if !strings.HasPrefix(req.ID, "req-") {
+			return &Error{Code: ErrCodeInvalidRequest, Message: "bad ID"}
+		}
+		return nil
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "req-1", TenantID: "t", Recipe: "echo", Payload: "hi"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
+
+func TestWithRequestValidator_RunsAfterBuiltInChecks(t *testing.T) {
+	var validated []string
+	orch := New(WithRequestValidator(func(req SubRequest) *Error {
+		validated = append(validated, req.ID)
+		return nil
+	}))
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "", Recipe: "echo"}, // fails built-in check
+	})
+
+	if results[0].Status != 400 {
+		t.Errorf("Status = %d, want 400", results[0].Status)
+	}
+	if len(validated) != 0 {
+		t.Errorf("validator ran for %v, want not at all (built-in check rejects first)", validated)
+	}
+}
+
+func TestWithRequestValidator_NilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithRequestValidator(nil) did not panic")
+		}
+	}()
+	New(WithRequestValidator(nil))
+}
@@ -0,0 +1,77 @@
+package relayer
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// memoryWatermark gates batch admission on process heap usage.
+// Heap readings are cached briefly so large batch rates don't pay
+// runtime.ReadMemStats on every admission check.
+type memoryWatermark struct {
+	limit uint64 // Heap bytes above which new batches are rejected
+
+	mu       sync.Mutex
+	lastRead time.Time
+	lastHeap uint64
+}
+
+// watermarkCacheInterval is how long a heap reading stays fresh.
+const watermarkCacheInterval = 100 * time.Millisecond
+
+// WithMemoryWatermark rejects new batches while process heap usage is
+// above the given number of bytes. Rejected requests receive a 503
+// response with ErrCodeOverloaded, preventing OOM kills when many
+// large batches arrive simultaneously.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithMemoryWatermark(1 << 30)) // 1 GiB
+func WithMemoryWatermark(bytes uint64) Option {
+	return func(o *Orchestrator) {
+		if bytes == 0 {
+			panic("memory watermark must be positive")
+		}
+		o.watermark = &memoryWatermark{limit: bytes}
+	}
+}
+
+// exceeded reports whether heap usage is above the watermark, along with
+// the observed heap size.
+func (w *memoryWatermark) exceeded() (bool, uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if time.Since(w.lastRead) > watermarkCacheInterval {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		w.lastHeap = stats.HeapAlloc
+		w.lastRead = time.Now()
+	}
+
+	return w.lastHeap > w.limit, w.lastHeap
+}
+
+// rejectBatch builds 503 responses for every request in a batch rejected
+// at admission time.
+func (w *memoryWatermark) rejectBatch(batch []SubRequest, heap uint64) []Response {
+	results := make([]Response, len(batch))
+	for i, req := range batch {
+		results[i] = Response{
+			ID:       req.ID,
+			Status:   503,
+			TenantID: req.TenantID,
+			Error: &Error{
+				Code:    ErrCodeOverloaded,
+				Message: fmt.Sprintf("batch rejected: heap usage %d bytes exceeds watermark %d", heap, w.limit),
+				Details: map[string]interface{}{
+					"heap_bytes":      heap,
+					"watermark_bytes": w.limit,
+				},
+			},
+		}
+	}
+	return results
+}
@@ -0,0 +1,50 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMemoryWatermark_RejectsAboveLimit(t *testing.T) {
+	// A 1-byte watermark is always exceeded by a running process.
+	orch := New(WithMemoryWatermark(1))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo"},
+		{ID: "2", TenantID: "tenant-b", Recipe: "echo"},
+	})
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+
+	for _, resp := range results {
+		if resp.Status != 503 {
+			t.Errorf("Request %s status = %d, want 503", resp.ID, resp.Status)
+		}
+		if resp.Error == nil || resp.Error.Code != ErrCodeOverloaded {
+			t.Errorf("Request %s error = %v, want code %s", resp.ID, resp.Error, ErrCodeOverloaded)
+		}
+	}
+}
+
+func TestMemoryWatermark_AdmitsBelowLimit(t *testing.T) {
+	// A huge watermark never triggers.
+	orch := New(WithMemoryWatermark(1 << 62))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	results := orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "tenant-a", Recipe: "echo", Payload: "hi"},
+	})
+
+	if results[0].Status != 200 {
+		t.Errorf("Status = %d, want 200", results[0].Status)
+	}
+}
@@ -0,0 +1,85 @@
+package relayer
+
+import "sync"
+
+// workerPool is a Scheduler backed by a fixed set of long-lived worker
+// goroutines that are reused across batches, avoiding goroutine spawn
+// and scheduler overhead on large batches. Workers are started lazily on
+// first use and stopped by Shutdown.
+type workerPool struct {
+	tasks chan func()
+	size  int
+	once  sync.Once
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newWorkerPool(size int) *workerPool {
+	return &workerPool{
+		// A modest buffer keeps ExecuteBatch from blocking on every
+		// Schedule while still providing back-pressure on huge batches.
+		tasks: make(chan func(), size*4),
+		size:  size,
+		done:  make(chan struct{}),
+	}
+}
+
+// Schedule enqueues the task for a pooled worker, blocking for
+// back-pressure when all workers are busy and the queue is full.
+func (p *workerPool) Schedule(task func()) {
+	p.once.Do(p.start)
+	p.tasks <- task
+}
+
+func (p *workerPool) start() {
+	p.wg.Add(p.size)
+	for i := 0; i < p.size; i++ {
+		go p.worker()
+	}
+}
+
+func (p *workerPool) worker() {
+	defer p.wg.Done()
+	for {
+		select {
+		case task := <-p.tasks:
+			task()
+		case <-p.done:
+			// Drain remaining queued tasks so no scheduled work is lost.
+			for {
+				select {
+				case task := <-p.tasks:
+					task()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// stop shuts down the workers after draining queued tasks. Safe to call
+// only once; Shutdown guards this via the orchestrator's closed flag.
+func (p *workerPool) stop() {
+	p.once.Do(p.start) // Ensure start state is settled before stopping
+	close(p.done)
+	p.wg.Wait()
+}
+
+// WithWorkerPool replaces goroutine-per-request dispatch with a fixed
+// pool of n reusable worker goroutines. For large batches this removes
+// goroutine spawn and scheduler overhead; n also acts as an execution
+// concurrency ceiling. Workers are stopped by Shutdown. Panics if n
+// is <= 0.
+//
+// Example:
+//
+//	orch := relayer.New(relayer.WithWorkerPool(64))
+func WithWorkerPool(n int) Option {
+	return func(o *Orchestrator) {
+		if n <= 0 {
+			panic("worker pool size must be positive")
+		}
+		o.scheduler = newWorkerPool(n)
+	}
+}
@@ -0,0 +1,110 @@
+package relayer
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestWithWorkerPool_ExecutesBatch(t *testing.T) {
+	orch := New(WithWorkerPool(4))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	batch := make([]SubRequest, 100)
+	for i := range batch {
+		batch[i] = SubRequest{ID: "r", TenantID: "t", Recipe: "echo", Payload: i}
+	}
+
+	results := orch.ExecuteBatch(context.Background(), batch)
+
+	if len(results) != 100 {
+		t.Fatalf("got %d results, want 100", len(results))
+	}
+	for i, resp := range results {
+		if resp.Status != 200 {
+			t.Fatalf("results[%d].Status = %d, want 200", i, resp.Status)
+		}
+	}
+}
+
+func TestWithWorkerPool_BoundsConcurrency(t *testing.T) {
+	const poolSize = 2
+	orch := New(WithWorkerPool(poolSize))
+
+	var mu sync.Mutex
+	current, peak := 0, 0
+
+	orch.RegisterRecipe("track", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		mu.Lock()
+		current++
+		if current > peak {
+			peak = current
+		}
+		mu.Unlock()
+
+		mu.Lock()
+		current--
+		mu.Unlock()
+		return nil, nil
+	})
+
+	batch := make([]SubRequest, 50)
+	for i := range batch {
+		batch[i] = SubRequest{ID: "r", TenantID: "t", Recipe: "track"}
+	}
+	orch.ExecuteBatch(context.Background(), batch)
+
+	if peak > poolSize {
+		t.Errorf("peak concurrency = %d, want <= %d", peak, poolSize)
+	}
+}
+
+func TestWithWorkerPool_ReusedAcrossBatches(t *testing.T) {
+	orch := New(WithWorkerPool(2))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	for i := 0; i < 5; i++ {
+		results := orch.ExecuteBatch(context.Background(), []SubRequest{
+			{ID: "1", TenantID: "t", Recipe: "echo", Payload: i},
+		})
+		if results[0].Status != 200 {
+			t.Fatalf("batch %d status = %d, want 200", i, results[0].Status)
+		}
+	}
+}
+
+func TestWithWorkerPool_ShutdownStopsWorkers(t *testing.T) {
+	orch := New(WithWorkerPool(2))
+
+	orch.RegisterRecipe("echo", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return payload, nil
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "1", TenantID: "t", Recipe: "echo"},
+	})
+
+	if err := orch.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+
+	// A second Shutdown must not panic on an already-stopped pool.
+	if err := orch.Shutdown(context.Background()); err != nil {
+		t.Errorf("repeated Shutdown = %v, want nil", err)
+	}
+}
+
+func TestWithWorkerPool_InvalidSizePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("WithWorkerPool(0) should panic")
+		}
+	}()
+	New(WithWorkerPool(0))
+}
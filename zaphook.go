@@ -0,0 +1,82 @@
+package relayer
+
+import (
+	"context"
+	"time"
+)
+
+// SugaredLogger is the subset of zap's *zap.SugaredLogger used by
+// ZapHook. Declaring the interface here keeps the library free of a zap
+// dependency — pass logger.Sugar() from a zap logger, or any
+// implementation with the same methods.
+type SugaredLogger interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+}
+
+// ZapHook is a ready-made ExecutionHook and PanicHook for zap-style
+// sugared loggers, logging the same structured fields as SlogHook:
+// tenant, recipe, request ID, trace ID, status, and duration. Starts
+// log at debug, successful completions at info, failures and panics at
+// error.
+//
+// Example:
+//
+//	hook := relayer.NewZapHook(zapLogger.Sugar())
+//	orch := relayer.New(
+//		relayer.WithExecutionHook(hook),
+//		relayer.WithPanicHook(hook),
+//	)
+type ZapHook struct {
+	logger SugaredLogger
+}
+
+// NewZapHook creates a structured logging hook writing to logger.
+// Panics if logger is nil.
+func NewZapHook(logger SugaredLogger) *ZapHook {
+	if logger == nil {
+		panic("zap hook logger cannot be nil")
+	}
+	return &ZapHook{logger: logger}
+}
+
+// OnStart logs the start of a recipe execution at debug level.
+func (h *ZapHook) OnStart(ctx context.Context, req SubRequest) {
+	h.logger.Debugw("recipe started",
+		"tenant", req.TenantID,
+		"recipe", req.Recipe,
+		"request_id", req.ID,
+		"trace_id", req.TraceID,
+	)
+}
+
+// OnComplete logs the completion: info for successes, error for
+// failures.
+func (h *ZapHook) OnComplete(ctx context.Context, req SubRequest, resp Response, duration time.Duration) {
+	fields := []interface{}{
+		"tenant", req.TenantID,
+		"recipe", req.Recipe,
+		"request_id", req.ID,
+		"trace_id", req.TraceID,
+		"status", resp.Status,
+		"duration", duration,
+	}
+	if resp.Error != nil {
+		fields = append(fields, "error_code", resp.Error.Code, "error", resp.Error.Message)
+		h.logger.Errorw("recipe failed", fields...)
+		return
+	}
+	h.logger.Infow("recipe completed", fields...)
+}
+
+// OnPanic logs recovered panics at error level.
+func (h *ZapHook) OnPanic(ctx context.Context, req SubRequest, recovered interface{}) {
+	h.logger.Errorw("recipe panicked",
+		"tenant", req.TenantID,
+		"recipe", req.Recipe,
+		"request_id", req.ID,
+		"trace_id", req.TraceID,
+		"panic", recovered,
+	)
+}
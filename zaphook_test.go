@@ -0,0 +1,88 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// fakeSugaredLogger records zap-style calls for assertions.
+type fakeSugaredLogger struct {
+	mu      sync.Mutex
+	entries []fakeLogEntry
+}
+
+type fakeLogEntry struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+func (l *fakeSugaredLogger) log(level, msg string, kv []interface{}) {
+	fields := make(map[string]interface{}, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		fields[kv[i].(string)] = kv[i+1]
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, fakeLogEntry{level: level, msg: msg, fields: fields})
+}
+
+func (l *fakeSugaredLogger) Debugw(msg string, kv ...interface{}) { l.log("debug", msg, kv) }
+func (l *fakeSugaredLogger) Infow(msg string, kv ...interface{})  { l.log("info", msg, kv) }
+func (l *fakeSugaredLogger) Errorw(msg string, kv ...interface{}) { l.log("error", msg, kv) }
+
+func (l *fakeSugaredLogger) find(msg string) (fakeLogEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range l.entries {
+		if e.msg == msg {
+			return e, true
+		}
+	}
+	return fakeLogEntry{}, false
+}
+
+func TestZapHook_LogsStructuredFields(t *testing.T) {
+	logger := &fakeSugaredLogger{}
+	hook := NewZapHook(logger)
+
+	orch := New(WithExecutionHook(hook), WithPanicHook(hook))
+	orch.RegisterRecipe("ok", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	orch.RegisterRecipe("bad", func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	orch.ExecuteBatch(context.Background(), []SubRequest{
+		{ID: "req-1", TenantID: "acme", Recipe: "ok"},
+		{ID: "req-2", TenantID: "acme", Recipe: "bad"},
+	})
+
+	completed, ok := logger.find("recipe completed")
+	if !ok {
+		t.Fatal("no completion entry logged")
+	}
+	if completed.level != "info" || completed.fields["tenant"] != "acme" || completed.fields["status"] != 200 {
+		t.Errorf("completion entry = %+v, want info with tenant/status fields", completed)
+	}
+
+	failed, ok := logger.find("recipe failed")
+	if !ok {
+		t.Fatal("no failure entry logged")
+	}
+	if failed.level != "error" || failed.fields["error_code"] != ErrCodeRecipeExecution {
+		t.Errorf("failure entry = %+v, want error level with error_code", failed)
+	}
+}
+
+func TestZapHook_NilLoggerPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewZapHook(nil) should panic")
+		}
+	}()
+	NewZapHook(nil)
+}